@@ -2,6 +2,7 @@ package conf
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/davecgh/go-spew/spew"
@@ -23,17 +24,41 @@ type config struct {
 	Sv struct {
 		Timeout         int64
 		Port            string
+		AdminPort       string
 		Debug           bool
 		DefaultPageSize int64
 		ImageChunkSize  int64
 	}
 	Nats struct {
-		URL        string
-		ClusterID  string
-		QueueGroup string
+		URL             string
+		ClusterID       string
+		QueueGroup      string
+		AckWait         int64
+		MaxRedeliveries int64
+	}
+	Outbox struct {
+		RelayInterval   int64
+		RelayBatchSize  int64
+		RelayMaxBackoff int64
+		MaxAttempts     int64
+		Retention       int64
+		PurgeInterval   int64
+		PurgeBatchSize  int64
+	}
+	Saga struct {
+		Timeout        int64
+		MaxRetries     int64
+		SweepInterval  int64
+		SweepBatchSize int64
+		WatchInterval  int64
+	}
+	Closer struct {
+		Interval  int64
+		BatchSize int64
 	}
 	API struct {
 		ImageURL string `mapstructure:"image_url"`
+		ChatURL  string `mapstructure:"chat_url"`
 	}
 }
 
@@ -47,7 +72,16 @@ func init() {
 
 	viper.SetConfigName("conf")
 	viper.SetConfigType("yml")
-	viper.AddConfigPath(dir + "/conf")
+
+	// go testはパッケージのディレクトリで実行されるので、親のディレクトリのconfも探す
+	for {
+		viper.AddConfigPath(filepath.Join(dir, "conf"))
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
 
 	viper.AutomaticEnv()
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
@@ -0,0 +1,3 @@
+ALTER TABLE `outbox`
+  DROP INDEX `published_at_created_at`,
+  DROP `published_at`;
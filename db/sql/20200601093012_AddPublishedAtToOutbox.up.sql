@@ -0,0 +1,3 @@
+ALTER TABLE `outbox`
+  ADD `published_at` DATETIME AFTER `channel`,
+  ADD INDEX `published_at_created_at` (`published_at`, `created_at`);
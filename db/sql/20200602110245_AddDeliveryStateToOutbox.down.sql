@@ -0,0 +1,3 @@
+ALTER TABLE `outbox`
+  DROP `last_error`,
+  DROP `attempts`;
@@ -0,0 +1,3 @@
+ALTER TABLE `outbox`
+  ADD `attempts` INT(11) NOT NULL DEFAULT 0 AFTER `published_at`,
+  ADD `last_error` VARCHAR(1000) AFTER `attempts`;
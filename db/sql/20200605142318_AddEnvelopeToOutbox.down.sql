@@ -0,0 +1,7 @@
+ALTER TABLE `outbox`
+  DROP `occurred_at`,
+  DROP `user_id`,
+  DROP `producer`,
+  DROP `causation_id`,
+  DROP `correlation_id`,
+  DROP `schema_version`;
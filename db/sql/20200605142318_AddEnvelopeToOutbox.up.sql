@@ -0,0 +1,7 @@
+ALTER TABLE `outbox`
+  ADD `schema_version` INT(11) NOT NULL DEFAULT 1 AFTER `channel`,
+  ADD `correlation_id` VARCHAR(255) AFTER `schema_version`,
+  ADD `causation_id` VARCHAR(255) AFTER `correlation_id`,
+  ADD `producer` VARCHAR(255) NOT NULL DEFAULT 'fishapp-post' AFTER `causation_id`,
+  ADD `user_id` INT(11) AFTER `producer`,
+  ADD `occurred_at` DATETIME AFTER `user_id`;
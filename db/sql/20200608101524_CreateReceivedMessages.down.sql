@@ -0,0 +1 @@
+DROP TABLE `received_messages`;
\ No newline at end of file
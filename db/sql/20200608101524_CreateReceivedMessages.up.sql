@@ -0,0 +1,6 @@
+CREATE TABLE `received_messages`(
+  `id` VARCHAR(255) PRIMARY KEY,
+  `event_type` VARCHAR(255) NOT NULL,
+  `created_at` DATETIME NOT NULL,
+  INDEX `created_at` (`created_at`)
+);
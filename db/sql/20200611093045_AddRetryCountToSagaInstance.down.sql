@@ -0,0 +1,3 @@
+ALTER TABLE `saga_instance`
+  DROP INDEX `saga_type_current_state_updated_at`,
+  DROP `retry_count`;
@@ -0,0 +1,3 @@
+ALTER TABLE `saga_instance`
+  ADD `retry_count` INT NOT NULL DEFAULT 0 AFTER `current_state`,
+  ADD INDEX `saga_type_current_state_updated_at` (`saga_type`, `current_state`, `updated_at`);
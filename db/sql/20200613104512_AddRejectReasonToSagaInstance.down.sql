@@ -0,0 +1,2 @@
+ALTER TABLE `saga_instance`
+  DROP `reject_reason`;
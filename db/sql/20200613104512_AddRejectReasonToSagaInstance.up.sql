@@ -0,0 +1,2 @@
+ALTER TABLE `saga_instance`
+  ADD `reject_reason` VARCHAR(1000) AFTER `retry_count`;
@@ -0,0 +1,11 @@
+CREATE TABLE `saga_transitions`(
+  `id` BIGINT AUTO_INCREMENT PRIMARY KEY,
+  `saga_id` VARCHAR(255) NOT NULL,
+  `event` VARCHAR(255) NOT NULL,
+  `from_state` VARCHAR(255) NOT NULL,
+  `to_state` VARCHAR(255) NOT NULL,
+  `payload` JSON,
+  `error_message` VARCHAR(1000),
+  `created_at` DATETIME NOT NULL,
+  INDEX `saga_id_id` (`saga_id`, `id`)
+);
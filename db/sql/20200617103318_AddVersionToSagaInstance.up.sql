@@ -0,0 +1,2 @@
+ALTER TABLE `saga_instance`
+  ADD `version` INT NOT NULL DEFAULT 0 AFTER `reject_reason`;
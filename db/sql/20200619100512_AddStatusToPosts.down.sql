@@ -0,0 +1,3 @@
+ALTER TABLE `posts`
+  DROP INDEX `status`,
+  DROP `status`;
@@ -0,0 +1,3 @@
+ALTER TABLE `posts`
+  ADD `status` VARCHAR(20) NOT NULL DEFAULT 'published' AFTER `user_id`,
+  ADD INDEX `status` (`status`);
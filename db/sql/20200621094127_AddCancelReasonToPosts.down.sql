@@ -0,0 +1,2 @@
+ALTER TABLE `posts`
+  DROP `cancel_reason`;
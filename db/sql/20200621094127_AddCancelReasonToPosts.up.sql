@@ -0,0 +1,2 @@
+ALTER TABLE `posts`
+  ADD `cancel_reason` VARCHAR(1000) AFTER `status`;
@@ -0,0 +1,2 @@
+ALTER TABLE `posts`
+  ADD `apply_deadline` DATETIME AFTER `meeting_at`;
@@ -0,0 +1,3 @@
+ALTER TABLE `apply_posts`
+  DROP INDEX `post_id_status`,
+  DROP `status`;
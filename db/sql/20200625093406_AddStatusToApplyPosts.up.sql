@@ -0,0 +1,3 @@
+ALTER TABLE `apply_posts`
+  ADD `status` VARCHAR(20) NOT NULL DEFAULT 'accepted' AFTER `user_id`,
+  ADD INDEX `post_id_status` (`post_id`, `status`);
@@ -0,0 +1,3 @@
+ALTER TABLE `apply_posts`
+  DROP `party_size`,
+  DROP `note`;
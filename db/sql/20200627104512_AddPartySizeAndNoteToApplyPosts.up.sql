@@ -0,0 +1,3 @@
+ALTER TABLE `apply_posts`
+  ADD `party_size` INT(11) NOT NULL DEFAULT 1 AFTER `status`,
+  ADD `note` VARCHAR(500) NOT NULL DEFAULT '' AFTER `party_size`;
@@ -0,0 +1,4 @@
+ALTER TABLE `outbox`
+  DROP INDEX `aggregate_type_aggregate_id_seq`,
+  DROP INDEX `published_at_seq`,
+  DROP `seq`;
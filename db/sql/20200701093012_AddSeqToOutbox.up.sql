@@ -0,0 +1,4 @@
+ALTER TABLE `outbox`
+  ADD `seq` BIGINT NOT NULL AUTO_INCREMENT UNIQUE AFTER `id`,
+  ADD INDEX `published_at_seq` (`published_at`, `seq`),
+  ADD INDEX `aggregate_type_aggregate_id_seq` (`aggregate_type`, `aggregate_id`, `seq`);
@@ -13,21 +13,41 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func NewGrpcServer(middL middleware.Middleware, postController pb.PostServiceServer) *grpc.Server {
+func NewGrpcServer(
+	middL middleware.Middleware,
+	postController pb.PostServiceServer,
+) *grpc.Server {
+	server := newGrpcServer(middL)
+	pb.RegisterPostServiceServer(server, postController)
+	return server
+}
+
+// 管理用のサービスは外部に公開しないポートで別に提供する
+func NewAdminGrpcServer(
+	middL middleware.Middleware,
+	postAdminController pb.PostAdminServiceServer,
+) *grpc.Server {
+	server := newGrpcServer(middL)
+	pb.RegisterPostAdminServiceServer(server, postAdminController)
+	return server
+}
+
+func newGrpcServer(middL middleware.Middleware) *grpc.Server {
 	server := grpc.NewServer(
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			middL.UnaryLogingInterceptor(),
 			middL.UnaryValidationInterceptor(),
 			middL.UnaryRecoveryInterceptor(),
+			middL.UnaryEventMetadataInterceptor(),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			middL.StreamLogingInterceptor(),
 			middL.StreamValidationInterceptor(),
 			middL.StreamRecoveryInterceptor(),
+			middL.StreamEventMetadataInterceptor(),
 		)),
 	)
 
-	pb.RegisterPostServiceServer(server, postController)
 	grpc_health_v1.RegisterHealthServer(server, &healthHandler{})
 	reflection.Register(server)
 	return server
@@ -0,0 +1,61 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"log"
+)
+
+// MySQLのGET_LOCKを使ったリーダー選出。ロックは取った接続に紐づくので、専用の接続を持ち続ける
+// プロセスが落ちたり接続が切れたりするとロックも外れて、他のレプリカが取れるようになる
+type leaderLock struct {
+	db   *sql.DB
+	name string
+	conn *sql.Conn
+}
+
+func newLeaderLock(db *sql.DB, name string) *leaderLock {
+	return &leaderLock{db: db, name: name}
+}
+
+// リーダーかどうかを返す。ロックを持っていなければ待たずに取りにいく
+// 1つのgoroutineからだけ呼ぶ
+func (l *leaderLock) TryAcquire(ctx context.Context) (bool, error) {
+	if l.conn != nil {
+		var held sql.NullBool
+		err := l.conn.QueryRowContext(ctx, "SELECT IS_USED_LOCK(?) = CONNECTION_ID()", l.name).Scan(&held)
+		if err == nil && held.Valid && held.Bool {
+			return true, nil
+		}
+
+		// 接続が切れているか、ロックが外れている
+		if err != nil {
+			log.Printf("lost leader lock %s: %s", l.name, err)
+		} else {
+			log.Printf("lost leader lock %s", l.name)
+		}
+		l.conn.Close()
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// 0秒でタイムアウトさせて、他のレプリカが持っている場合はすぐに諦める
+	var acquired sql.NullBool
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", l.name).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, err
+	}
+
+	if !acquired.Valid || !acquired.Bool {
+		conn.Close()
+		return false, nil
+	}
+
+	log.Printf("acquired leader lock %s", l.name)
+	l.conn = conn
+	return true, nil
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/google/uuid"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	correlationIDMDKey = "x-correlation-id"
+	causationIDMDKey   = "x-causation-id"
+	requestIDMDKey     = "x-request-id"
+	userIDMDKey        = "x-user-id"
+)
+
+func (*middleware) UnaryEventMetadataInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(contextWithEventMetadata(ctx), req)
+	}
+}
+
+func (*middleware) StreamEventMetadataInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = contextWithEventMetadata(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+// リクエストのメタデータからイベントに引き継ぐ値を取り出してctxに入れる
+// correlation_idがなければこのリクエストを起点として新しく発行する
+func contextWithEventMetadata(ctx context.Context) context.Context {
+	m := &models.EventMetadata{}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	m.CorrelationID = firstMDValue(md, correlationIDMDKey)
+	m.CausationID = firstMDValue(md, causationIDMDKey)
+	if m.CausationID == "" {
+		m.CausationID = firstMDValue(md, requestIDMDKey)
+	}
+	if m.CorrelationID == "" {
+		m.CorrelationID = uuid.New().String()
+	}
+	if m.CausationID == "" {
+		m.CausationID = m.CorrelationID
+	}
+	if uID, err := strconv.ParseInt(firstMDValue(md, userIDMDKey), 10, 64); err == nil {
+		m.UserID = uID
+	}
+
+	return models.ContextWithEventMetadata(ctx, m)
+}
+
+func firstMDValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
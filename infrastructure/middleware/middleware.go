@@ -8,10 +8,12 @@ type Middleware interface {
 	UnaryLogingInterceptor() grpc.UnaryServerInterceptor
 	UnaryRecoveryInterceptor() grpc.UnaryServerInterceptor
 	UnaryValidationInterceptor() grpc.UnaryServerInterceptor
+	UnaryEventMetadataInterceptor() grpc.UnaryServerInterceptor
 
 	StreamLogingInterceptor() grpc.StreamServerInterceptor
 	StreamRecoveryInterceptor() grpc.StreamServerInterceptor
 	StreamValidationInterceptor() grpc.StreamServerInterceptor
+	StreamEventMetadataInterceptor() grpc.StreamServerInterceptor
 }
 
 type middleware struct{}
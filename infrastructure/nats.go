@@ -2,16 +2,35 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/ezio1119/fishapp-post/conf"
 	"github.com/ezio1119/fishapp-post/interfaces/controllers"
+	"github.com/ezio1119/fishapp-post/models"
 	"github.com/ezio1119/fishapp-post/pb"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
 	"github.com/nats-io/stan.go"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+const (
+	createPostSagaReplyChannel = "create.post.saga.reply"
+	deletePostSagaReplyChannel = "delete.post.saga.reply"
+)
+
+// 再配信しても成功しないエラー。すぐにデッドレターに送る
+type unrecoverableErr struct {
+	err error
+}
+
+func (e *unrecoverableErr) Error() string {
+	return e.err.Error()
+}
+
 func NewNatsStreamingConn() (stan.Conn, error) {
 	clientID := uuid.New().String()
 	log.Printf("nats clientID is %s", clientID)
@@ -20,42 +39,143 @@ func NewNatsStreamingConn() (stan.Conn, error) {
 }
 
 func StartSubscribeCreatePostSagaReply(conn stan.Conn, c controllers.SagaReplyController) error {
-	_, err := conn.QueueSubscribe("create.post.saga.reply", conf.C.Nats.QueueGroup, func(m *stan.Msg) {
-		ctx := context.Background()
-		e := &pb.Event{}
-		if err := protojson.Unmarshal(m.MsgProto.Data, e); err != nil {
-			log.Printf("error failed unmarshal protojson: %s", err)
-			return
-		}
+	return subscribeSagaReply(conn, createPostSagaReplyChannel, func(ctx context.Context, e *pb.Event) error {
+		return handleCreatePostSagaReply(ctx, c, e)
+	})
+}
 
-		log.Printf("recieved event: %#v\n", e)
+func StartSubscribeDeletePostSagaReply(conn stan.Conn, c controllers.SagaReplyController) error {
+	return subscribeSagaReply(conn, deletePostSagaReplyChannel, func(ctx context.Context, e *pb.Event) error {
+		return handleDeletePostSagaReply(ctx, c, e)
+	})
+}
 
-		switch e.EventType {
-		case "room.created":
-			data := &pb.RoomCreated{}
-			if err := protojson.Unmarshal(e.EventData, data); err != nil {
-				log.Printf("error failed unmarshal protojson: %s", err)
-				return
-			}
-			if err := c.RoomCreated(ctx, data); err != nil {
-				log.Println(err)
-				return
-			}
-		case "create.room.failed":
-			data := &pb.CreateRoomFailed{}
-			if err := protojson.Unmarshal(e.EventData, data); err != nil {
-				log.Printf("error failed unmarshal protojson: %s", err)
+// 処理に成功するか、デッドレターに送ったメッセージだけをackする
+func subscribeSagaReply(conn stan.Conn, channel string, handle func(ctx context.Context, e *pb.Event) error) error {
+	_, err := conn.QueueSubscribe(channel, conf.C.Nats.QueueGroup, func(m *stan.Msg) {
+		if err := handleSagaReply(context.Background(), m, handle); err != nil {
+			log.Printf("error failed handle %s message seq=%d: %s", channel, m.Sequence, err)
+			// ackしなければAckWait後に再配信される
+			if !shouldDeadLetter(m, err) {
 				return
 			}
-			if err := c.CreateRoomFailed(ctx, data); err != nil {
-				log.Println(err)
+			if err := publishDeadLetter(conn, m, err); err != nil {
+				log.Printf("error failed publish dead letter: %s", err)
 				return
 			}
 		}
 
-	}, stan.DurableName(conf.C.Nats.QueueGroup))
+		if err := m.Ack(); err != nil {
+			log.Printf("error failed ack message seq=%d: %s", m.Sequence, err)
+		}
+	},
+		stan.DurableName(conf.C.Nats.QueueGroup),
+		stan.SetManualAckMode(),
+		stan.AckWait(time.Duration(conf.C.Nats.AckWait)*time.Second),
+	)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func handleSagaReply(ctx context.Context, m *stan.Msg, handle func(ctx context.Context, e *pb.Event) error) error {
+	e := &pb.Event{}
+	if err := protojson.Unmarshal(m.MsgProto.Data, e); err != nil {
+		return &unrecoverableErr{fmt.Errorf("failed unmarshal protojson: %w", err)}
+	}
+
+	// 重複の判定に使うので、IDの無いメッセージは処理しない
+	if e.Id == "" {
+		return &unrecoverableErr{errors.New("event id is empty")}
+	}
+
+	log.Printf("recieved event: %#v\n", e)
+
+	return handle(contextWithEventMetadata(ctx, e), e)
+}
+
+func handleCreatePostSagaReply(ctx context.Context, c controllers.SagaReplyController, e *pb.Event) error {
+	switch e.EventType {
+	case "room.created":
+		data := &pb.RoomCreated{}
+		if err := protojson.Unmarshal(e.EventData, data); err != nil {
+			return &unrecoverableErr{fmt.Errorf("failed unmarshal protojson: %w", err)}
+		}
+		return c.RoomCreated(ctx, e.Id, data)
+	case "create.room.failed":
+		data := &pb.CreateRoomFailed{}
+		if err := protojson.Unmarshal(e.EventData, data); err != nil {
+			return &unrecoverableErr{fmt.Errorf("failed unmarshal protojson: %w", err)}
+		}
+		return c.CreateRoomFailed(ctx, e.Id, data)
+	default:
+		log.Printf("unknown event type %s. skip", e.EventType)
+	}
+
+	return nil
+}
+
+func handleDeletePostSagaReply(ctx context.Context, c controllers.SagaReplyController, e *pb.Event) error {
+	switch e.EventType {
+	case "room.deleted":
+		data := &pb.RoomDeleted{}
+		if err := protojson.Unmarshal(e.EventData, data); err != nil {
+			return &unrecoverableErr{fmt.Errorf("failed unmarshal protojson: %w", err)}
+		}
+		return c.RoomDeleted(ctx, e.Id, data)
+	case "delete.room.failed":
+		data := &pb.DeleteRoomFailed{}
+		if err := protojson.Unmarshal(e.EventData, data); err != nil {
+			return &unrecoverableErr{fmt.Errorf("failed unmarshal protojson: %w", err)}
+		}
+		return c.DeleteRoomFailed(ctx, e.Id, data)
+	default:
+		log.Printf("unknown event type %s. skip", e.EventType)
+	}
+
+	return nil
+}
+
+func shouldDeadLetter(m *stan.Msg, err error) bool {
+	var unrecoverable *unrecoverableErr
+	if errors.As(err, &unrecoverable) {
+		return true
+	}
+	return int64(m.RedeliveryCount) >= conf.C.Nats.MaxRedeliveries
+}
+
+// 処理できなかったメッセージを元のペイロードとエラーと一緒に<channel>.dlqに送る
+func publishDeadLetter(conn stan.Conn, m *stan.Msg, handleErr error) error {
+	fAt, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+
+	data, err := protojson.Marshal(&pb.DeadLetter{
+		Channel:         m.Subject,
+		Data:            m.Data,
+		ErrorMessage:    handleErr.Error(),
+		RedeliveryCount: m.RedeliveryCount,
+		FailedAt:        fAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return conn.Publish(m.Subject+".dlq", data)
+}
+
+// 受信したイベントを起点に発行するイベントへcorrelation_idを引き継ぎ、causation_idにはそのイベントのIDを入れる
+func contextWithEventMetadata(ctx context.Context, e *pb.Event) context.Context {
+	correlationID := e.CorrelationId
+	if correlationID == "" {
+		correlationID = e.Id
+	}
+
+	return models.ContextWithEventMetadata(ctx, &models.EventMetadata{
+		CorrelationID: correlationID,
+		CausationID:   e.Id,
+		UserID:        e.UserId,
+	})
+}
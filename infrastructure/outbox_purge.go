@@ -0,0 +1,38 @@
+package infrastructure
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/conf"
+	"github.com/ezio1119/fishapp-post/usecase/interactor"
+)
+
+// 発行済みで保持期間を過ぎたoutboxと、保持期間を過ぎた受信メッセージの記録を定期的に削除する
+func StartOutboxPurge(i interactor.OutboxInteractor) {
+	interval := time.Duration(conf.C.Outbox.PurgeInterval) * time.Minute
+	retention := time.Duration(conf.C.Outbox.Retention) * time.Hour
+	batchSize := conf.C.Outbox.PurgeBatchSize
+
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for range t.C {
+			cnt, err := i.PurgeOutboxes(context.Background(), retention, batchSize)
+			if err != nil {
+				log.Printf("error failed purge outbox: %s", err)
+			} else if cnt != 0 {
+				log.Printf("purged %d published outbox rows", cnt)
+			}
+
+			cnt, err = i.PurgeReceivedMessages(context.Background(), retention, batchSize)
+			if err != nil {
+				log.Printf("error failed purge received messages: %s", err)
+			} else if cnt != 0 {
+				log.Printf("purged %d received message rows", cnt)
+			}
+		}
+	}()
+}
@@ -0,0 +1,41 @@
+package infrastructure
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/conf"
+	"github.com/ezio1119/fishapp-post/usecase/interactor"
+)
+
+// outboxテーブルをポーリングして、未発行のイベントをnatsに発行し続ける
+func StartOutboxRelay(i interactor.OutboxInteractor) {
+	interval := time.Duration(conf.C.Outbox.RelayInterval) * time.Millisecond
+	maxBackoff := time.Duration(conf.C.Outbox.RelayMaxBackoff) * time.Millisecond
+	batchSize := conf.C.Outbox.RelayBatchSize
+	maxAttempts := conf.C.Outbox.MaxAttempts
+
+	go func() {
+		backoff := interval
+		for {
+			cnt, err := i.RelayOutboxes(context.Background(), maxAttempts, batchSize)
+			if err != nil {
+				log.Printf("error failed relay outbox: %s. retry after %s", err, backoff)
+				time.Sleep(backoff)
+				backoff *= 2
+				if backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+				continue
+			}
+			backoff = interval
+
+			// まだ未発行の行が残っている可能性があるのですぐに次を取りに行く
+			if cnt == batchSize {
+				continue
+			}
+			time.Sleep(interval)
+		}
+	}()
+}
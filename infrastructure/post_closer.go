@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/conf"
+	"github.com/ezio1119/fishapp-post/usecase/interactor"
+)
+
+const postCloserLockName = "fishapp-post.post-closer"
+
+// meeting_atを過ぎた投稿を定期的に締め切る
+// レプリカが複数あっても、リーダーのロックを取れた1台だけが実行する
+func StartPostCloser(db *sql.DB, i interactor.PostCloseInteractor) {
+	interval := time.Duration(conf.C.Closer.Interval) * time.Second
+	batchSize := conf.C.Closer.BatchSize
+	lock := newLeaderLock(db, postCloserLockName)
+
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for range t.C {
+			leader, err := lock.TryAcquire(context.Background())
+			if err != nil {
+				log.Printf("error failed acquire leader lock: %s", err)
+				continue
+			}
+			if !leader {
+				continue
+			}
+
+			cnt, err := i.ClosePastPosts(context.Background(), batchSize)
+			if err != nil {
+				log.Printf("error failed close past posts: %s", err)
+				continue
+			}
+			if cnt != 0 {
+				log.Printf("closed %d posts", cnt)
+			}
+		}
+	}()
+}
@@ -0,0 +1,29 @@
+package infrastructure
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/conf"
+	"github.com/ezio1119/fishapp-post/usecase/interactor"
+)
+
+// リプライが返ってこずに止まっているサガを定期的に探して、再試行か補償をする
+func StartSagaTimeoutSweeper(i interactor.SagaTimeoutInteractor) {
+	interval := time.Duration(conf.C.Saga.SweepInterval) * time.Second
+	timeout := time.Duration(conf.C.Saga.Timeout) * time.Second
+	maxRetries := conf.C.Saga.MaxRetries
+	batchSize := conf.C.Saga.SweepBatchSize
+
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for range t.C {
+			if _, err := i.SweepTimedOutSagas(context.Background(), timeout, maxRetries, batchSize); err != nil {
+				log.Printf("error failed sweep timed out saga: %s", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/pb"
+	"github.com/ezio1119/fishapp-post/usecase/interactor"
+	"github.com/golang/protobuf/ptypes"
+)
+
+type postAdminController struct {
+	sagaInteractor      interactor.SagaInteractor
+	sagaAdminInteractor interactor.SagaAdminInteractor
+}
+
+func NewPostAdminController(su interactor.SagaInteractor, sau interactor.SagaAdminInteractor) *postAdminController {
+	return &postAdminController{su, sau}
+}
+
+func (c *postAdminController) ListSagaTransitions(ctx context.Context, in *pb.ListSagaTransitionsReq) (*pb.ListSagaTransitionsRes, error) {
+	list, err := c.sagaInteractor.ListSagaTransitions(ctx, in.SagaId)
+	if err != nil {
+		return nil, err
+	}
+	listProto, err := convListSagaTransitionsProto(list)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListSagaTransitionsRes{Transitions: listProto}, nil
+}
+
+func (c *postAdminController) ListSagas(ctx context.Context, in *pb.ListSagasReq) (*pb.ListSagasRes, error) {
+	f := &models.SagaInstanceFilter{
+		SagaType: in.SagaType,
+		States:   in.States,
+	}
+
+	if in.UpdatedBefore != nil {
+		uBefore, err := ptypes.Timestamp(in.UpdatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		f.UpdatedBefore = uBefore
+	}
+
+	list, err := c.sagaAdminInteractor.ListSagaInstances(ctx, f, in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	listProto, err := convListSagaStatusesProto(list)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListSagasRes{Sagas: listProto}, nil
+}
+
+func (c *postAdminController) RetrySagaStep(ctx context.Context, in *pb.RetrySagaStepReq) (*pb.SagaStatus, error) {
+	sagaIn, err := c.sagaAdminInteractor.RetrySagaStep(ctx, in.SagaId)
+	if err != nil {
+		return nil, err
+	}
+	return convSagaStatusProto(sagaIn)
+}
+
+func (c *postAdminController) ForceCompensate(ctx context.Context, in *pb.ForceCompensateReq) (*pb.SagaStatus, error) {
+	sagaIn, err := c.sagaAdminInteractor.ForceCompensate(ctx, in.SagaId, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return convSagaStatusProto(sagaIn)
+}
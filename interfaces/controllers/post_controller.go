@@ -17,14 +17,15 @@ import (
 
 type postController struct {
 	postInteractor interactor.PostInteractor
+	sagaInteractor interactor.SagaInteractor
 }
 
-func NewPostController(pu interactor.PostInteractor) *postController {
-	return &postController{pu}
+func NewPostController(pu interactor.PostInteractor, su interactor.SagaInteractor) *postController {
+	return &postController{pu, su}
 }
 
 func (c *postController) GetPost(ctx context.Context, in *pb.GetPostReq) (*pb.Post, error) {
-	p, err := c.postInteractor.GetPost(ctx, in.Id)
+	p, err := c.postInteractor.GetPost(ctx, in.Id, in.RequesterId)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +41,7 @@ func (c *postController) ListPosts(ctx context.Context, in *pb.ListPostsReq) (*p
 		FishingSpotTypeID: in.Filter.FishingSpotTypeId,
 		PrefectureID:      in.Filter.PrefectureId,
 		UserID:            in.Filter.UserId,
-	}, in.PageSize, in.PageToken, f)
+	}, in.PageSize, in.PageToken, f, in.Filter.RequesterId)
 
 	if err != nil {
 		return nil, err
@@ -59,6 +60,7 @@ func (c *postController) CreatePost(stream pb.PostService_CreatePostServer) erro
 	p := &models.Post{}
 
 	imageBufs := []*bytes.Buffer{}
+	draft := false
 
 	for {
 		req, err := stream.Recv()
@@ -86,6 +88,15 @@ func (c *postController) CreatePost(stream pb.PostService_CreatePostServer) erro
 			p.MeetingAt = mAt
 			p.MaxApply = x.Info.MaxApply
 			p.UserID = x.Info.UserId
+			draft = x.Info.Draft
+
+			if x.Info.ApplyDeadline != nil {
+				dAt, err := ptypes.Timestamp(x.Info.ApplyDeadline)
+				if err != nil {
+					return err
+				}
+				p.ApplyDeadline = dAt
+			}
 
 		case *pb.CreatePostReq_ImageChunk:
 
@@ -108,9 +119,17 @@ func (c *postController) CreatePost(stream pb.PostService_CreatePostServer) erro
 		}
 	}
 
-	sagaID, err := c.postInteractor.CreatePost(ctx, p, imageBufs)
-	if err != nil {
-		return err
+	sagaID := ""
+	if draft {
+		if err := c.postInteractor.CreateDraftPost(ctx, p, imageBufs); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		sagaID, err = c.postInteractor.CreatePost(ctx, p, imageBufs)
+		if err != nil {
+			return err
+		}
 	}
 
 	pProto, err := convPostProto(p)
@@ -159,6 +178,14 @@ func (c *postController) UpdatePost(stream pb.PostService_UpdatePostServer) erro
 			p.MaxApply = x.Info.MaxApply
 			dltImageIDs = x.Info.ImageIdsToDelete
 
+			if x.Info.ApplyDeadline != nil {
+				dAt, err := ptypes.Timestamp(x.Info.ApplyDeadline)
+				if err != nil {
+					return err
+				}
+				p.ApplyDeadline = dAt
+			}
+
 		case *pb.UpdatePostReq_ImageChunk:
 
 			if len(imageBufs) == 0 {
@@ -194,12 +221,35 @@ func (c *postController) UpdatePost(stream pb.PostService_UpdatePostServer) erro
 	return stream.SendAndClose(pProto)
 }
 
-func (c *postController) DeletePost(ctx context.Context, in *pb.DeletePostReq) (*empty.Empty, error) {
-	if err := c.postInteractor.DeletePost(ctx, in.Id); err != nil {
+func (c *postController) DeletePost(ctx context.Context, in *pb.DeletePostReq) (*pb.DeletePostRes, error) {
+	sagaID, err := c.postInteractor.DeletePost(ctx, in.Id)
+	if err != nil {
 		return nil, err
 	}
 
-	return &empty.Empty{}, nil
+	return &pb.DeletePostRes{Success: true, SagaId: sagaID}, nil
+}
+
+func (c *postController) PublishPost(ctx context.Context, in *pb.PublishPostReq) (*pb.CreatePostRes, error) {
+	p, sagaID, err := c.postInteractor.PublishPost(ctx, in.Id, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	pProto, err := convPostProto(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePostRes{Post: pProto, SagaId: sagaID}, nil
+}
+
+func (c *postController) CancelPost(ctx context.Context, in *pb.CancelPostReq) (*pb.Post, error) {
+	p, err := c.postInteractor.CancelPost(ctx, in.Id, in.UserId, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return convPostProto(p)
 }
 
 func (c *postController) GetApplyPost(ctx context.Context, in *pb.GetApplyPostReq) (*pb.ApplyPost, error) {
@@ -242,8 +292,10 @@ func (c *postController) BatchGetApplyPostsByPostIDs(ctx context.Context, in *pb
 
 func (c *postController) CreateApplyPost(ctx context.Context, in *pb.CreateApplyPostReq) (*pb.ApplyPost, error) {
 	a := &models.ApplyPost{
-		PostID: in.PostId,
-		UserID: in.UserId,
+		PostID:    in.PostId,
+		UserID:    in.UserId,
+		PartySize: in.PartySize,
+		Note:      in.Note,
 	}
 	err := c.postInteractor.CreateApplyPost(ctx, a)
 	if err != nil {
@@ -252,9 +304,43 @@ func (c *postController) CreateApplyPost(ctx context.Context, in *pb.CreateApply
 	return convApplyPostProto(a)
 }
 
+func (c *postController) AcceptApplyPost(ctx context.Context, in *pb.AcceptApplyPostReq) (*pb.ApplyPost, error) {
+	a, err := c.postInteractor.AcceptApplyPost(ctx, in.Id, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return convApplyPostProto(a)
+}
+
+func (c *postController) DeclineApplyPost(ctx context.Context, in *pb.DeclineApplyPostReq) (*pb.ApplyPost, error) {
+	a, err := c.postInteractor.DeclineApplyPost(ctx, in.Id, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return convApplyPostProto(a)
+}
+
 func (c *postController) DeleteApplyPost(ctx context.Context, in *pb.DeleteApplyPostReq) (*empty.Empty, error) {
 	if err := c.postInteractor.DeleteApplyPost(ctx, in.Id); err != nil {
 		return nil, err
 	}
 	return &empty.Empty{}, nil
 }
+
+func (c *postController) GetSagaStatus(ctx context.Context, in *pb.GetSagaStatusReq) (*pb.SagaStatus, error) {
+	sagaIn, err := c.sagaInteractor.GetSagaInstance(ctx, in.SagaId)
+	if err != nil {
+		return nil, err
+	}
+	return convSagaStatusProto(sagaIn)
+}
+
+func (c *postController) WatchSaga(in *pb.WatchSagaReq, stream pb.PostService_WatchSagaServer) error {
+	return c.sagaInteractor.WatchSagaInstance(stream.Context(), in.SagaId, func(sagaIn *models.SagaInstance) error {
+		sProto, err := convSagaStatusProto(sagaIn)
+		if err != nil {
+			return err
+		}
+		return stream.Send(sProto)
+	})
+}
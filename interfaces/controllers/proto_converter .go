@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"strings"
 	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
@@ -21,6 +22,10 @@ func convPostProto(p *models.Post) (*pb.Post, error) {
 	if err != nil {
 		return nil, err
 	}
+	dAt, err := ptypes.TimestampProto(p.ApplyDeadline)
+	if err != nil {
+		return nil, err
+	}
 	return &pb.Post{
 		Id:                p.ID,
 		Title:             p.Title,
@@ -30,14 +35,26 @@ func convPostProto(p *models.Post) (*pb.Post, error) {
 		PrefectureId:      p.PrefectureID,
 		MeetingPlaceId:    p.MeetingPlaceID,
 		MeetingAt:         mAt,
+		ApplyDeadline:     dAt,
 		MaxApply:          p.MaxApply,
 		UserId:            p.UserID,
+		Status:            convPostStatusProto(p.Status),
+		CancelReason:      p.CancelReason,
 		CreatedAt:         cAt,
 		UpdatedAt:         uAt,
 	}, nil
 
 }
 
+// ステータスの文字列をprotoのenumにする。enumの名前はステータスを大文字にしたもの
+func convPostStatusProto(s string) pb.Post_Status {
+	return pb.Post_Status(pb.Post_Status_value[strings.ToUpper(s)])
+}
+
+func convApplyPostStatusProto(s string) pb.ApplyPost_Status {
+	return pb.ApplyPost_Status(pb.ApplyPost_Status_value[strings.ToUpper(s)])
+}
+
 func convListPostsProto(list []*models.Post) ([]*pb.Post, error) {
 	listP := make([]*pb.Post, len(list))
 	for i, p := range list {
@@ -63,6 +80,9 @@ func convApplyPostProto(a *models.ApplyPost) (*pb.ApplyPost, error) {
 		Id:        a.ID,
 		PostId:    a.PostID,
 		UserId:    a.UserID,
+		Status:    convApplyPostStatusProto(a.Status),
+		PartySize: a.PartySize,
+		Note:      a.Note,
 		CreatedAt: cAt,
 		UpdatedAt: uAt,
 	}, nil
@@ -81,7 +101,7 @@ func convListApplyPostsProto(list []*models.ApplyPost) ([]*pb.ApplyPost, error)
 }
 
 func convPostFilter(f *pb.ListPostsReq_Filter) (*models.PostFilter, error) {
-	postF := &models.PostFilter{CanApply: f.CanApply, FishTypeIDs: f.FishTypeIds}
+	postF := &models.PostFilter{CanApply: f.CanApply, FishTypeIDs: f.FishTypeIds, Draft: f.Draft}
 
 	if f.MeetingAtFrom != nil {
 		mAtFrom, err := ptypes.Timestamp(f.MeetingAtFrom)
@@ -113,3 +133,65 @@ func convPostFilter(f *pb.ListPostsReq_Filter) (*models.PostFilter, error) {
 	}
 	return postF, nil
 }
+
+func convSagaStatusProto(i *models.SagaInstance) (*pb.SagaStatus, error) {
+	cAt, err := ptypes.TimestampProto(i.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	uAt, err := ptypes.TimestampProto(i.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SagaStatus{
+		SagaId:       i.ID,
+		SagaType:     i.SagaType,
+		CurrentState: i.CurrentState,
+		RejectReason: i.RejectReason,
+		Finished:     i.Finished,
+		CreatedAt:    cAt,
+		UpdatedAt:    uAt,
+		RetryCount:   i.RetryCount,
+	}, nil
+}
+
+func convListSagaStatusesProto(list []*models.SagaInstance) ([]*pb.SagaStatus, error) {
+	listS := make([]*pb.SagaStatus, len(list))
+	for i, sagaIn := range list {
+		sProto, err := convSagaStatusProto(sagaIn)
+		if err != nil {
+			return nil, err
+		}
+		listS[i] = sProto
+	}
+	return listS, nil
+}
+
+func convSagaTransitionProto(t *models.SagaTransition) (*pb.SagaTransition, error) {
+	cAt, err := ptypes.TimestampProto(t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SagaTransition{
+		Id:           t.ID,
+		SagaId:       t.SagaID,
+		Event:        t.Event,
+		FromState:    t.FromState,
+		ToState:      t.ToState,
+		Payload:      string(t.Payload),
+		ErrorMessage: t.ErrorMessage,
+		CreatedAt:    cAt,
+	}, nil
+}
+
+func convListSagaTransitionsProto(list []*models.SagaTransition) ([]*pb.SagaTransition, error) {
+	listT := make([]*pb.SagaTransition, len(list))
+	for i, t := range list {
+		tProto, err := convSagaTransitionProto(t)
+		if err != nil {
+			return nil, err
+		}
+		listT[i] = tProto
+	}
+	return listT, nil
+}
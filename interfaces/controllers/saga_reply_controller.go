@@ -12,20 +12,22 @@ type sagaReplyController struct {
 }
 
 type SagaReplyController interface {
-	RoomCreated(ctx context.Context, e *pb.RoomCreated) error
-	CreateRoomFailed(ctx context.Context, e *pb.CreateRoomFailed) error
+	RoomCreated(ctx context.Context, eventID string, e *pb.RoomCreated) error
+	CreateRoomFailed(ctx context.Context, eventID string, e *pb.CreateRoomFailed) error
+	RoomDeleted(ctx context.Context, eventID string, e *pb.RoomDeleted) error
+	DeleteRoomFailed(ctx context.Context, eventID string, e *pb.DeleteRoomFailed) error
 }
 
 func NewSagaReplyController(i interactor.SagaReplyInteractor) SagaReplyController {
 	return &sagaReplyController{i}
 }
 
-func (c *sagaReplyController) RoomCreated(ctx context.Context, e *pb.RoomCreated) error {
-	return c.sagaReplyInteractor.RoomCreated(ctx, e.SagaId)
+func (c *sagaReplyController) RoomCreated(ctx context.Context, eventID string, e *pb.RoomCreated) error {
+	return c.sagaReplyInteractor.RoomCreated(ctx, eventID, e.SagaId)
 }
 
-func (c *sagaReplyController) CreateRoomFailed(ctx context.Context, e *pb.CreateRoomFailed) error {
-	return c.sagaReplyInteractor.CreateRoomFailed(ctx, e.SagaId, e.Message)
+func (c *sagaReplyController) CreateRoomFailed(ctx context.Context, eventID string, e *pb.CreateRoomFailed) error {
+	return c.sagaReplyInteractor.CreateRoomFailed(ctx, eventID, e.SagaId, e.Message)
 	// for _, detail := range e.ErrorStatus.Details {
 	// 	switch t := detail.(type) {
 	// 	case *errdetails.BadRequest:
@@ -37,3 +39,11 @@ func (c *sagaReplyController) CreateRoomFailed(ctx context.Context, e *pb.Create
 	// 	}
 	// }
 }
+
+func (c *sagaReplyController) RoomDeleted(ctx context.Context, eventID string, e *pb.RoomDeleted) error {
+	return c.sagaReplyInteractor.RoomDeleted(ctx, eventID, e.SagaId)
+}
+
+func (c *sagaReplyController) DeleteRoomFailed(ctx context.Context, eventID string, e *pb.DeleteRoomFailed) error {
+	return c.sagaReplyInteractor.DeleteRoomFailed(ctx, eventID, e.SagaId, e.Message)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
 	"github.com/ezio1119/fishapp-post/usecase/repo"
@@ -47,6 +48,9 @@ func (r *applyPostRepo) fetchApplyPosts(ctx context.Context, query string, args
 			&a.ID,
 			&a.PostID,
 			&a.UserID,
+			&a.Status,
+			&a.PartySize,
+			&a.Note,
 			&a.UpdatedAt,
 			&a.CreatedAt,
 		)
@@ -61,7 +65,7 @@ func (r *applyPostRepo) fetchApplyPosts(ctx context.Context, query string, args
 }
 
 func (r *applyPostRepo) GetApplyPostByID(ctx context.Context, id int64) (*models.ApplyPost, error) {
-	query := `SELECT id, post_id, user_id, updated_at, created_at
+	query := `SELECT id, post_id, user_id, status, party_size, note, updated_at, created_at
                         FROM apply_posts
                         WHERE id = ?`
 	list, err := r.fetchApplyPosts(ctx, query, id)
@@ -74,22 +78,61 @@ func (r *applyPostRepo) GetApplyPostByID(ctx context.Context, id int64) (*models
 	return list[0], nil
 }
 
+// 最新の行をロックして読む。トランザクションのctxで呼ぶ
+func (r *applyPostRepo) GetApplyPostByIDForUpdate(ctx context.Context, id int64) (*models.ApplyPost, error) {
+	query := `SELECT id, post_id, user_id, status, party_size, note, updated_at, created_at
+                        FROM apply_posts
+                        WHERE id = ?
+                        FOR UPDATE`
+	list, err := r.fetchApplyPosts(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Errorf(codes.NotFound, "apply_post with id='%d' is not found", id)
+	}
+	return list[0], nil
+}
+
+func (r *applyPostRepo) GetApplyPostByPostIDAndUserID(ctx context.Context, postID int64, userID int64) (*models.ApplyPost, error) {
+	query := `SELECT id, post_id, user_id, status, party_size, note, updated_at, created_at
+                        FROM apply_posts
+                        WHERE post_id = ? AND user_id = ?`
+	list, err := r.fetchApplyPosts(ctx, query, postID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Errorf(codes.NotFound, "apply_post with post_id='%d' and user_id='%d' is not found", postID, userID)
+	}
+	return list[0], nil
+}
+
 func (r *applyPostRepo) ListApplyPostsByUserID(ctx context.Context, uID int64) ([]*models.ApplyPost, error) {
-	query := `SELECT id, post_id, user_id, updated_at, created_at
+	query := `SELECT id, post_id, user_id, status, party_size, note, updated_at, created_at
                         FROM apply_posts
                         WHERE user_id = ?`
 	return r.fetchApplyPosts(ctx, query, uID)
 }
 
 func (r *applyPostRepo) ListApplyPostsByPostID(ctx context.Context, pID int64) ([]*models.ApplyPost, error) {
-	query := `SELECT id, post_id, user_id, updated_at, created_at
+	query := `SELECT id, post_id, user_id, status, party_size, note, updated_at, created_at
                         FROM apply_posts
                         WHERE post_id = ?`
 	return r.fetchApplyPosts(ctx, query, pID)
 }
 
+// waitlistedの行はステータスが変わるまで更新しないので、updated_atが空き待ちになった時刻になる
+func (r *applyPostRepo) ListWaitlistedApplyPostsByPostID(ctx context.Context, pID int64) ([]*models.ApplyPost, error) {
+	query := `SELECT id, post_id, user_id, status, party_size, note, updated_at, created_at
+                        FROM apply_posts
+                        WHERE post_id = ? AND status = ?
+                        ORDER BY updated_at, id`
+	return r.fetchApplyPosts(ctx, query, pID, models.ApplyPostStatusWaitlisted)
+}
+
 func (r *applyPostRepo) BatchGetApplyPostsByPostIDs(ctx context.Context, pIDs []int64) ([]*models.ApplyPost, error) {
-	query := `SELECT id, post_id, user_id, updated_at, created_at
+	query := `SELECT id, post_id, user_id, status, party_size, note, updated_at, created_at
                         FROM apply_posts
                         WHERE post_id IN(?` + strings.Repeat(",?", len(pIDs)-1) + ")"
 
@@ -100,10 +143,10 @@ func (r *applyPostRepo) BatchGetApplyPostsByPostIDs(ctx context.Context, pIDs []
 	return r.fetchApplyPosts(ctx, query, args...)
 }
 
-func (r *applyPostRepo) CountApplyPostsByPostID(ctx context.Context, postID int64) (int64, error) {
-	query := `SELECT COUNT(*)
+func (r *applyPostRepo) CountAcceptedSeatsByPostID(ctx context.Context, postID int64) (int64, error) {
+	query := `SELECT IFNULL(SUM(party_size), 0)
                      FROM apply_posts
-										 WHERE post_id = ?`
+										 WHERE post_id = ? AND status = ?`
 
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
 	if err != nil {
@@ -112,7 +155,7 @@ func (r *applyPostRepo) CountApplyPostsByPostID(ctx context.Context, postID int6
 	defer stmt.Close()
 
 	var cnt int64
-	rows, err := stmt.QueryContext(ctx, postID)
+	rows, err := stmt.QueryContext(ctx, postID, models.ApplyPostStatusAccepted)
 	if err != nil {
 		return 0, err
 	}
@@ -130,14 +173,14 @@ func (r *applyPostRepo) CountApplyPostsByPostID(ctx context.Context, postID int6
 }
 
 func (r *applyPostRepo) CreateApplyPost(ctx context.Context, p *models.ApplyPost) error {
-	query := `INSERT apply_posts SET post_id=?, user_id=?, updated_at=?, created_at=?`
+	query := `INSERT apply_posts SET post_id=?, user_id=?, status=?, party_size=?, note=?, updated_at=?, created_at=?`
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	res, err := stmt.ExecContext(ctx, p.PostID, p.UserID, p.UpdatedAt, p.CreatedAt)
+	res, err := stmt.ExecContext(ctx, p.PostID, p.UserID, p.Status, p.PartySize, p.Note, p.UpdatedAt, p.CreatedAt)
 	if err != nil {
 		e, ok := err.(*mysql.MySQLError)
 		if ok {
@@ -162,6 +205,55 @@ func (r *applyPostRepo) CreateApplyPost(ctx context.Context, p *models.ApplyPost
 	return nil
 }
 
+func (r *applyPostRepo) UpdateApplyPost(ctx context.Context, p *models.ApplyPost) error {
+	query := `UPDATE apply_posts SET status=?, party_size=?, note=?, updated_at=? WHERE id = ?`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, p.Status, p.PartySize, p.Note, p.UpdatedAt, p.ID)
+	if err != nil {
+		return err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowCnt != 1 {
+		return fmt.Errorf("expected %d row affected, got %d rows affected", 1, rowCnt)
+	}
+
+	return nil
+}
+
+func (r *applyPostRepo) UpdateApplyPostStatus(ctx context.Context, id int64, status string, updatedAt time.Time) error {
+	query := `UPDATE apply_posts SET status=?, updated_at=? WHERE id = ?`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, status, updatedAt, id)
+	if err != nil {
+		return err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowCnt != 1 {
+		return fmt.Errorf("expected %d row affected, got %d rows affected", 1, rowCnt)
+	}
+	return nil
+}
+
 func (r *applyPostRepo) DeleteApplyPost(ctx context.Context, id int64) error {
 	query := `DELETE FROM apply_posts WHERE id = ?`
 
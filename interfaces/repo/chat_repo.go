@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/ezio1119/fishapp-post/pb"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type chatRepo struct {
+	client pb.ChatServiceClient
+}
+
+func NewChatRepo(c pb.ChatServiceClient) repo.ChatRepo {
+	return &chatRepo{c}
+}
+
+// 投稿のチャットルームにメンバーを追加する。既にメンバーの場合は何もしない
+func (r *chatRepo) CreateMember(ctx context.Context, pID int64, uID int64) error {
+	room, err := r.client.GetRoom(ctx, &pb.GetRoomReq{PostId: pID})
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.client.CreateMember(ctx, &pb.CreateMemberReq{
+		RoomId: room.Id,
+		UserId: uID,
+	}); err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// 投稿のチャットルームからメンバーを外す。ルームやメンバーが既に無い場合は何もしない
+func (r *chatRepo) DeleteMember(ctx context.Context, pID int64, uID int64) error {
+	room, err := r.client.GetRoom(ctx, &pb.GetRoomReq{PostId: pID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := r.client.DeleteMember(ctx, &pb.DeleteMemberReq{
+		RoomId: room.Id,
+		UserId: uID,
+	}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"github.com/nats-io/stan.go"
+)
+
+type eventRepo struct {
+	conn stan.Conn
+}
+
+func NewEventRepo(c stan.Conn) repo.EventRepo {
+	return &eventRepo{c}
+}
+
+func (r *eventRepo) PublishEvent(ctx context.Context, channel string, data []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return r.conn.Publish(channel, data)
+}
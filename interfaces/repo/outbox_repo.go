@@ -2,6 +2,9 @@ package repo
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
 	"github.com/ezio1119/fishapp-post/usecase/repo"
@@ -16,7 +19,7 @@ func NewOutboxRepo(s SqlHandler) repo.OutboxRepo {
 }
 
 func (r *outboxRepo) CreateOutbox(ctx context.Context, o *models.Outbox) error {
-	query := `INSERT outbox SET id=?, event_type=?, event_data=?, aggregate_id=?, aggregate_type=?, channel=?, updated_at=?, created_at=?`
+	query := `INSERT outbox SET id=?, event_type=?, event_data=?, aggregate_id=?, aggregate_type=?, channel=?, schema_version=?, correlation_id=?, causation_id=?, producer=?, user_id=?, occurred_at=?, updated_at=?, created_at=?`
 
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
 	if err != nil {
@@ -24,7 +27,7 @@ func (r *outboxRepo) CreateOutbox(ctx context.Context, o *models.Outbox) error {
 	}
 	defer stmt.Close()
 
-	res, err := stmt.ExecContext(ctx, o.ID, o.EventType, o.EventData, o.AggregateID, o.AggregateType, o.Channel, o.UpdatedAt, o.CreatedAt)
+	res, err := stmt.ExecContext(ctx, o.ID, o.EventType, o.EventData, o.AggregateID, o.AggregateType, o.Channel, o.SchemaVersion, o.CorrelationID, o.CausationID, o.Producer, o.UserID, o.OccurredAt, o.UpdatedAt, o.CreatedAt)
 	if err != nil {
 		return err
 	}
@@ -39,3 +42,142 @@ func (r *outboxRepo) CreateOutbox(ctx context.Context, o *models.Outbox) error {
 
 	return nil
 }
+
+func (r *outboxRepo) fetchOutboxes(ctx context.Context, query string, args ...interface{}) ([]*models.Outbox, error) {
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	result := make([]*models.Outbox, 0)
+	for rows.Next() {
+		o := new(models.Outbox)
+		if err := rows.Scan(
+			&o.ID,
+			&o.Seq,
+			&o.EventType,
+			&o.EventData,
+			&o.AggregateID,
+			&o.AggregateType,
+			&o.Channel,
+			&o.SchemaVersion,
+			&o.CorrelationID,
+			&o.CausationID,
+			&o.Producer,
+			&o.UserID,
+			&o.OccurredAt,
+			&o.PublishedAt,
+			&o.Attempts,
+			&o.PrevSeq,
+			&o.LastError,
+			&o.UpdatedAt,
+			&o.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, o)
+	}
+
+	return result, nil
+}
+
+// 複数のレプリカで同じ行を二重に発行しないようにトランザクション内でロックを取る
+// maxAttempts回失敗した行は発行を諦めたものとして返さない
+// 同じ集約の先の行が発行済みになったかを呼び出し側で確かめられるように、直前の未発行の行のseqも返す
+// サブクエリはロックしない読み取りなので、他のレプリカがロックしている行も未発行として数える
+func (r *outboxRepo) ListUnpublishedOutboxes(ctx context.Context, maxAttempts int64, num int64) ([]*models.Outbox, error) {
+	query := `SELECT o.id, o.seq, o.event_type, o.event_data, IFNULL(o.aggregate_id, ''), IFNULL(o.aggregate_type, ''), o.channel, o.schema_version, IFNULL(o.correlation_id, ''), IFNULL(o.causation_id, ''), o.producer, IFNULL(o.user_id, 0), IFNULL(o.occurred_at, o.created_at), o.published_at, o.attempts,
+						IFNULL((SELECT MAX(p.seq) FROM outbox p
+							WHERE p.aggregate_type = o.aggregate_type AND p.aggregate_id = o.aggregate_id AND p.aggregate_id <> '' AND p.published_at IS NULL AND p.seq < o.seq), 0),
+						IFNULL(o.last_error, ''), o.updated_at, o.created_at
+						FROM outbox o
+						WHERE o.published_at IS NULL AND o.attempts < ?
+						ORDER BY o.seq
+						LIMIT ?
+						FOR UPDATE SKIP LOCKED`
+
+	return r.fetchOutboxes(ctx, query, maxAttempts, num)
+}
+
+func (r *outboxRepo) MarkOutboxPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	query := `UPDATE outbox SET published_at=?, attempts=attempts+1, last_error=NULL WHERE id=?`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, publishedAt, id)
+	if err != nil {
+		return err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowCnt != 1 {
+		return fmt.Errorf("expected %d row affected, got %d rows affected", 1, rowCnt)
+	}
+
+	return nil
+}
+
+func (r *outboxRepo) MarkOutboxFailed(ctx context.Context, id string, errMsg string) error {
+	query := `UPDATE outbox SET attempts=attempts+1, last_error=? WHERE id=?`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	// last_errorはVARCHAR(1000)
+	res, err := stmt.ExecContext(ctx, truncateString(errMsg, 1000), id)
+	if err != nil {
+		return err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowCnt != 1 {
+		return fmt.Errorf("expected %d row affected, got %d rows affected", 1, rowCnt)
+	}
+
+	return nil
+}
+
+func (r *outboxRepo) DeletePublishedOutboxes(ctx context.Context, publishedBefore time.Time, num int64) (int64, error) {
+	query := `DELETE FROM outbox
+						WHERE published_at < ?
+						ORDER BY published_at
+						LIMIT ?`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, publishedBefore, num)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/ezio1119/fishapp-post/models"
@@ -51,8 +52,11 @@ func (r *postRepo) fetchPosts(ctx context.Context, query string, args ...interfa
 			&p.PrefectureID,
 			&p.MeetingPlaceID,
 			&p.MeetingAt,
+			&p.ApplyDeadline,
 			&p.MaxApply,
 			&p.UserID,
+			&p.Status,
+			&p.CancelReason,
 			&p.UpdatedAt,
 			&p.CreatedAt,
 		)
@@ -203,14 +207,14 @@ func (r *postRepo) deletePostsFishTypesByPostID(ctx context.Context, pID int64)
 }
 
 func (r *postRepo) CreatePost(ctx context.Context, p *models.Post) error {
-	query := `INSERT posts SET title=?, content=?, fishing_spot_type_id=?, prefecture_id=?, meeting_place_id=?, meeting_at=?, max_apply=?, user_id=?, updated_at=?, created_at=?`
+	query := `INSERT posts SET title=?, content=?, fishing_spot_type_id=?, prefecture_id=?, meeting_place_id=?, meeting_at=?, apply_deadline=?, max_apply=?, user_id=?, status=?, updated_at=?, created_at=?`
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	res, err := stmt.ExecContext(ctx, p.Title, p.Content, p.FishingSpotTypeID, p.PrefectureID, p.MeetingPlaceID, p.MeetingAt, p.MaxApply, p.UserID, p.UpdatedAt, p.CreatedAt)
+	res, err := stmt.ExecContext(ctx, p.Title, p.Content, p.FishingSpotTypeID, p.PrefectureID, p.MeetingPlaceID, p.MeetingAt, p.ApplyDeadline, p.MaxApply, p.UserID, p.Status, p.UpdatedAt, p.CreatedAt)
 	if err != nil {
 		return err
 	}
@@ -235,7 +239,7 @@ func (r *postRepo) CreatePost(ctx context.Context, p *models.Post) error {
 }
 
 func (r *postRepo) GetPostByID(ctx context.Context, id int64) (*models.Post, error) {
-	query := `SELECT id, title, content, fishing_spot_type_id, prefecture_id, meeting_place_id, meeting_at, max_apply, user_id, updated_at, created_at
+	query := `SELECT id, title, content, fishing_spot_type_id, prefecture_id, meeting_place_id, meeting_at, IFNULL(apply_deadline, meeting_at), max_apply, user_id, status, IFNULL(cancel_reason, ''), updated_at, created_at
             FROM posts
             WHERE id = ?`
 
@@ -255,8 +259,52 @@ func (r *postRepo) GetPostByID(ctx context.Context, id int64) (*models.Post, err
 	return list[0], nil
 }
 
+// 応募の枠を確認してから埋めるまで、同じ投稿への他の応募を待たせる。トランザクションのctxで呼ぶ
+func (r *postRepo) GetPostByIDForUpdate(ctx context.Context, id int64) (*models.Post, error) {
+	query := `SELECT id, title, content, fishing_spot_type_id, prefecture_id, meeting_place_id, meeting_at, IFNULL(apply_deadline, meeting_at), max_apply, user_id, status, IFNULL(cancel_reason, ''), updated_at, created_at
+            FROM posts
+            WHERE id = ?
+            FOR UPDATE`
+
+	list, err := r.fetchPosts(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, status.Errorf(codes.NotFound, "post with id='%d' is not found", id)
+	}
+
+	if err := r.fillPostWithFishTypeIDs(ctx, list[0]); err != nil {
+		return nil, err
+	}
+
+	return list[0], nil
+}
+
+func (r *postRepo) ListPublishedPostsMeetingBefore(ctx context.Context, before time.Time, num int64) ([]*models.Post, error) {
+	query := `SELECT id, title, content, fishing_spot_type_id, prefecture_id, meeting_place_id, meeting_at, IFNULL(apply_deadline, meeting_at), max_apply, user_id, status, IFNULL(cancel_reason, ''), updated_at, created_at
+            FROM posts
+            WHERE status = ? AND meeting_at <= ?
+            ORDER BY meeting_at asc
+            LIMIT ?`
+
+	posts, err := r.fetchPosts(ctx, query, models.PostStatusPublished, before, num)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(posts) != 0 {
+		if err := r.fillListPostsWithFishTypes(ctx, posts); err != nil {
+			return nil, err
+		}
+	}
+
+	return posts, nil
+}
+
 func (r *postRepo) ListPosts(ctx context.Context, p *models.Post, num int64, cursor int64, f *models.PostFilter) ([]*models.Post, error) {
-	sq := sq.Select("id, title, content, fishing_spot_type_id, prefecture_id, meeting_place_id, meeting_at, max_apply, user_id, updated_at, created_at").
+	sq := sq.Select("id, title, content, fishing_spot_type_id, prefecture_id, meeting_place_id, meeting_at, IFNULL(apply_deadline, meeting_at), max_apply, user_id, status, IFNULL(cancel_reason, ''), updated_at, created_at").
 		From("posts").
 		GroupBy("posts.id").
 		Limit(uint64(num))
@@ -273,9 +321,18 @@ func (r *postRepo) ListPosts(ctx context.Context, p *models.Post, num int64, cur
 		sq = sq.Where("user_id = ?", p.UserID)
 	}
 
+	if len(f.Statuses) != 0 {
+		args := make([]interface{}, len(f.Statuses))
+		for i, s := range f.Statuses {
+			args[i] = s
+		}
+		sq = sq.Where("posts.status IN(?"+strings.Repeat(",?", len(f.Statuses)-1)+")", args...)
+	}
+
 	if f.CanApply {
-		sq = sq.LeftJoin("apply_posts ON posts.id = apply_posts.post_id").
-			Having("count(apply_posts.id) < posts.max_apply")
+		// 魚種の絞り込みのJOINで行が増えても合計がずれないように、サブクエリで人数を合計する
+		sq = sq.Where("IFNULL(posts.apply_deadline, posts.meeting_at) > ?", time.Now()).
+			Where("(SELECT IFNULL(SUM(apply_posts.party_size), 0) FROM apply_posts WHERE apply_posts.post_id = posts.id AND apply_posts.status = ?) < posts.max_apply", models.ApplyPostStatusAccepted)
 	}
 
 	if f.FishTypeIDs != nil {
@@ -351,7 +408,7 @@ func (r *postRepo) ListPosts(ctx context.Context, p *models.Post, num int64, cur
 }
 
 func (r *postRepo) UpdatePost(ctx context.Context, p *models.Post) error {
-	query := `UPDATE posts SET title=?, content=?, fishing_spot_type_id=?, prefecture_id=?, meeting_place_id=?, meeting_at=?, max_apply=?, updated_at=?
+	query := `UPDATE posts SET title=?, content=?, fishing_spot_type_id=?, prefecture_id=?, meeting_place_id=?, meeting_at=?, apply_deadline=?, max_apply=?, updated_at=?
 						WHERE id = ?`
 
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
@@ -360,7 +417,7 @@ func (r *postRepo) UpdatePost(ctx context.Context, p *models.Post) error {
 	}
 	defer stmt.Close()
 
-	res, err := stmt.ExecContext(ctx, p.Title, p.Content, p.FishingSpotTypeID, p.PrefectureID, p.MeetingPlaceID, p.MeetingAt, p.MaxApply, p.UpdatedAt, p.ID)
+	res, err := stmt.ExecContext(ctx, p.Title, p.Content, p.FishingSpotTypeID, p.PrefectureID, p.MeetingPlaceID, p.MeetingAt, p.ApplyDeadline, p.MaxApply, p.UpdatedAt, p.ID)
 	if err != nil {
 		return err
 	}
@@ -385,6 +442,83 @@ func (r *postRepo) UpdatePost(ctx context.Context, p *models.Post) error {
 	return nil
 }
 
+func (r *postRepo) UpdatePostStatus(ctx context.Context, id int64, status string, updatedAt time.Time) error {
+	query := `UPDATE posts SET status=?, updated_at=? WHERE id = ?`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, status, updatedAt, id)
+	if err != nil {
+		return err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowCnt != 1 {
+		return fmt.Errorf("expected %d row affected, got %d rows affected", 1, rowCnt)
+	}
+
+	return nil
+}
+
+// 同時にキャンセルされた投稿を上書きしないよう、公開中の投稿だけを締め切る
+// 読んでからmeeting_atを先に変えられた投稿や、公開中でなくなった投稿は締め切らずにfalseを返す
+func (r *postRepo) ClosePost(ctx context.Context, id int64, meetingBefore time.Time, updatedAt time.Time) (bool, error) {
+	query := `UPDATE posts SET status=?, updated_at=? WHERE id = ? AND status = ? AND meeting_at <= ?`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return false, err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, models.PostStatusClosed, updatedAt, id, models.PostStatusPublished, meetingBefore)
+	if err != nil {
+		return false, err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowCnt == 1, nil
+}
+
+// 同時に消されたり下書きに戻されたりした投稿を上書きしないよう、公開中か締め切り後の投稿だけをキャンセルする
+func (r *postRepo) CancelPost(ctx context.Context, id int64, reason string, updatedAt time.Time) error {
+	query := `UPDATE posts SET status=?, cancel_reason=?, updated_at=? WHERE id = ? AND status IN(?, ?)`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, models.PostStatusCancelled, reason, updatedAt, id, models.PostStatusPublished, models.PostStatusClosed)
+	if err != nil {
+		return err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowCnt != 1 {
+		return status.Errorf(codes.FailedPrecondition, "post with id='%d' is neither published nor closed", id)
+	}
+
+	return nil
+}
+
 func (r *postRepo) DeletePost(ctx context.Context, id int64) error {
 	query := "DELETE FROM posts WHERE id = ?"
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"github.com/go-sql-driver/mysql"
+)
+
+type receivedMessageRepo struct {
+	SqlHandler
+}
+
+func NewReceivedMessageRepo(h SqlHandler) repo.ReceivedMessageRepo {
+	return &receivedMessageRepo{h}
+}
+
+func (r *receivedMessageRepo) ExistsReceivedMessage(ctx context.Context, id string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM received_messages WHERE id=?)`
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return false, err
+	}
+	defer stmt.Close()
+
+	var exists bool
+	if err := stmt.QueryRowContext(ctx, id).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// 既に同じIDのメッセージを処理していればMessageAlreadyReceivedを返す
+func (r *receivedMessageRepo) CreateReceivedMessage(ctx context.Context, m *models.ReceivedMessage) error {
+	query := `INSERT received_messages SET id=?, event_type=?, created_at=?`
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, m.ID, m.EventType, m.CreatedAt)
+	if err != nil {
+		if e, ok := err.(*mysql.MySQLError); ok && e.Number == 1062 {
+			return models.NewMessageAlreadyReceivedErr(m.ID)
+		}
+		return err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowCnt != 1 {
+		return fmt.Errorf("expected %d row affected, got %d rows affected", 1, rowCnt)
+	}
+
+	return nil
+}
+
+// createdBeforeより前に受信したメッセージの記録を古い順に最大num件削除して、削除した件数を返す
+func (r *receivedMessageRepo) DeleteReceivedMessages(ctx context.Context, createdBefore time.Time, num int64) (int64, error) {
+	query := `DELETE FROM received_messages
+						WHERE created_at < ?
+						ORDER BY created_at
+						LIMIT ?`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, createdBefore, num)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
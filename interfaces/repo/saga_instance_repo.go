@@ -4,9 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/ezio1119/fishapp-post/models"
 	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type sagaInstanceRepo struct {
@@ -18,14 +25,14 @@ func NewSagaInstanceRepo(h SqlHandler) repo.SagaInstanceRepo {
 }
 
 func (r *sagaInstanceRepo) CreateSagaInstance(ctx context.Context, i *models.SagaInstance) error {
-	query := `INSERT saga_instance SET id=?, saga_type=?, saga_data=?, current_state=?, updated_at=?, created_at=?`
+	query := `INSERT saga_instance SET id=?, saga_type=?, saga_data=?, current_state=?, version=?, updated_at=?, created_at=?`
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	res, err := stmt.ExecContext(ctx, i.ID, i.SagaType, i.SagaData, i.CurrentState, i.UpdatedAt, i.CreatedAt)
+	res, err := stmt.ExecContext(ctx, i.ID, i.SagaType, i.SagaData, i.CurrentState, i.Version, i.UpdatedAt, i.CreatedAt)
 	if err != nil {
 		return err
 	}
@@ -33,21 +40,23 @@ func (r *sagaInstanceRepo) CreateSagaInstance(ctx context.Context, i *models.Sag
 	if err != nil {
 		return err
 	}
-	if int(rowCnt) != 1 {
-		return err
+	if rowCnt != 1 {
+		return fmt.Errorf("expected %d row affected, got %d rows affected", 1, rowCnt)
 	}
 	return nil
 }
 
+// 読み込んだときのversionのままの場合だけ更新する。他で更新されていた場合はSagaInstanceConflictを返す
 func (r *sagaInstanceRepo) UpdateSagaInstance(ctx context.Context, i *models.SagaInstance) error {
-	query := `UPDATE saga_instance SET saga_data=?, current_state=?, updated_at=? WHERE id=?`
+	query := `UPDATE saga_instance SET saga_data=?, current_state=?, reject_reason=NULLIF(?, ''), version=version+1, updated_at=? WHERE id=? AND version=?`
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	res, err := stmt.ExecContext(ctx, i.SagaData, i.CurrentState, i.UpdatedAt, i.ID)
+	// reject_reasonはVARCHAR(1000)。補償の理由にはチャットなど他のサービスのエラーがそのまま入る
+	res, err := stmt.ExecContext(ctx, i.SagaData, i.CurrentState, truncateString(i.RejectReason, 1000), i.UpdatedAt, i.ID, i.Version)
 	if err != nil {
 		return err
 	}
@@ -55,14 +64,15 @@ func (r *sagaInstanceRepo) UpdateSagaInstance(ctx context.Context, i *models.Sag
 	if err != nil {
 		return err
 	}
-	if int(rowCnt) != 1 {
-		return err
+	if rowCnt != 1 {
+		return models.NewSagaInstanceConflictErr(i.ID, i.Version)
 	}
+	i.Version++
 	return nil
 }
 
 func (r *sagaInstanceRepo) GetSagaInstance(ctx context.Context, sagaID string) (*models.SagaInstance, error) {
-	query := `SELECT id, saga_type, saga_data, current_state, updated_at, created_at FROM saga_instance WHERE id=?`
+	query := `SELECT id, saga_type, saga_data, current_state, retry_count, IFNULL(reject_reason, ''), version, updated_at, created_at FROM saga_instance WHERE id=?`
 	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
 	if err != nil {
 		return nil, err
@@ -71,13 +81,148 @@ func (r *sagaInstanceRepo) GetSagaInstance(ctx context.Context, sagaID string) (
 
 	i := &models.SagaInstance{}
 
-	err = stmt.QueryRowContext(ctx, sagaID).Scan(&i.ID, &i.SagaType, &i.SagaData, &i.CurrentState, &i.UpdatedAt, &i.CreatedAt)
+	err = stmt.QueryRowContext(ctx, sagaID).Scan(&i.ID, &i.SagaType, &i.SagaData, &i.CurrentState, &i.RetryCount, &i.RejectReason, &i.Version, &i.UpdatedAt, &i.CreatedAt)
 	switch {
 	case err == sql.ErrNoRows:
-		return nil, fmt.Errorf("no saga_instance with id %s", sagaID)
+		return nil, status.Errorf(codes.NotFound, "saga_instance with id='%s' is not found", sagaID)
 	case err != nil:
 		return nil, err
 	}
 
 	return i, nil
 }
+
+func (r *sagaInstanceRepo) fetchSagaInstances(ctx context.Context, query string, args ...interface{}) ([]*models.SagaInstance, error) {
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	result := make([]*models.SagaInstance, 0)
+	for rows.Next() {
+		i := new(models.SagaInstance)
+		if err := rows.Scan(&i.ID, &i.SagaType, &i.SagaData, &i.CurrentState, &i.RetryCount, &i.RejectReason, &i.Version, &i.UpdatedAt, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// 更新が古い順に最大num件返す
+func (r *sagaInstanceRepo) ListSagaInstances(ctx context.Context, f *models.SagaInstanceFilter, num int64) ([]*models.SagaInstance, error) {
+	q := sq.Select("id, saga_type, saga_data, current_state, retry_count, IFNULL(reject_reason, ''), version, updated_at, created_at").
+		From("saga_instance").
+		OrderBy("updated_at asc").
+		Limit(uint64(num))
+
+	if f.SagaType != "" {
+		q = q.Where("saga_type = ?", f.SagaType)
+	}
+
+	if len(f.States) != 0 {
+		q = q.Where(sq.Eq{"current_state": f.States})
+	}
+
+	if !f.UpdatedBefore.IsZero() {
+		q = q.Where("updated_at < ?", f.UpdatedBefore)
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.fetchSagaInstances(ctx, query, args...)
+}
+
+// statesのどれかで、beforeより前から更新されていないサガを古い順に最大num件返す
+func (r *sagaInstanceRepo) ListStaleSagaInstances(ctx context.Context, sagaType string, states []string, before time.Time, num int64) ([]*models.SagaInstance, error) {
+	if len(states) == 0 {
+		return []*models.SagaInstance{}, nil
+	}
+
+	query := `SELECT id, saga_type, saga_data, current_state, retry_count, IFNULL(reject_reason, ''), version, updated_at, created_at
+						FROM saga_instance
+						WHERE saga_type = ? AND current_state IN(?` + strings.Repeat(",?", len(states)-1) + `) AND updated_at < ?
+						ORDER BY updated_at
+						LIMIT ?`
+
+	args := make([]interface{}, 0, len(states)+3)
+	args = append(args, sagaType)
+	for _, s := range states {
+		args = append(args, s)
+	}
+	args = append(args, before, num)
+
+	return r.fetchSagaInstances(ctx, query, args...)
+}
+
+func (r *sagaInstanceRepo) IncrementSagaRetryCount(ctx context.Context, sagaID string, version int64, updatedAt time.Time) error {
+	query := `UPDATE saga_instance SET retry_count=retry_count+1, version=version+1, updated_at=? WHERE id=? AND version=?`
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, updatedAt, sagaID, version)
+	if err != nil {
+		return err
+	}
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowCnt != 1 {
+		return models.NewSagaInstanceConflictErr(sagaID, version)
+	}
+	return nil
+}
+
+// saga_dataのidがdataIDで、finishedStatesのどれでもないサガがあるかを返す
+// protojsonはint64を文字列にするので、文字列で比べる
+func (r *sagaInstanceRepo) ExistsUnfinishedSagaInstance(ctx context.Context, sagaType string, finishedStates []string, dataID int64) (bool, error) {
+	q := sq.Select("1").
+		From("saga_instance").
+		Where("saga_type = ?", sagaType).
+		Where("saga_data->>'$.id' = ?", strconv.FormatInt(dataID, 10))
+
+	if len(finishedStates) != 0 {
+		q = q.Where(sq.NotEq{"current_state": finishedStates})
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, "SELECT EXISTS("+query+")")
+	if err != nil {
+		return false, err
+	}
+	defer stmt.Close()
+
+	var exists bool
+	if err := stmt.QueryRowContext(ctx, args...).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,94 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+)
+
+type sagaTransitionRepo struct {
+	SqlHandler
+}
+
+func NewSagaTransitionRepo(h SqlHandler) repo.SagaTransitionRepo {
+	return &sagaTransitionRepo{h}
+}
+
+func (r *sagaTransitionRepo) CreateSagaTransition(ctx context.Context, t *models.SagaTransition) error {
+	query := `INSERT saga_transitions SET saga_id=?, event=?, from_state=?, to_state=?, payload=?, error_message=NULLIF(?, ''), created_at=?`
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	// JSON型のカラムに空のバイト列は入れられないのでNULLにする
+	var payload interface{}
+	if len(t.Payload) != 0 {
+		payload = t.Payload
+	}
+
+	// error_messageはVARCHAR(1000)。補償の理由にはチャットなど他のサービスのエラーがそのまま入る
+	res, err := stmt.ExecContext(ctx, t.SagaID, t.Event, t.FromState, t.ToState, payload, truncateString(t.ErrorMessage, 1000), t.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	rowCnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowCnt != 1 {
+		return fmt.Errorf("expected %d row affected, got %d rows affected", 1, rowCnt)
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	t.ID = lastID
+
+	return nil
+}
+
+// 遷移した順に返す
+func (r *sagaTransitionRepo) ListSagaTransitions(ctx context.Context, sagaID string) ([]*models.SagaTransition, error) {
+	query := `SELECT id, saga_id, event, from_state, to_state, IFNULL(payload, ''), IFNULL(error_message, ''), created_at
+						FROM saga_transitions
+						WHERE saga_id = ?
+						ORDER BY id`
+
+	stmt, err := r.SqlHandler.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	result := make([]*models.SagaTransition, 0)
+	for rows.Next() {
+		t := new(models.SagaTransition)
+		if err := rows.Scan(&t.ID, &t.SagaID, &t.Event, &t.FromState, &t.ToState, &t.Payload, &t.ErrorMessage, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -11,3 +11,11 @@ type SqlHandler interface {
 	Commit(ctx context.Context) (context.Context, error)
 	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
 }
+
+// VARCHAR(n)のカラムに入るように、文字数でn文字に切り詰める
+func truncateString(str string, n int) string {
+	if r := []rune(str); len(r) > n {
+		return string(r[:n])
+	}
+	return str
+}
@@ -0,0 +1,129 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+)
+
+type outboxRepo struct {
+	*Store
+}
+
+func NewOutboxRepo(s *Store) repo.OutboxRepo {
+	return &outboxRepo{s}
+}
+
+// seqの順に並べておく
+func (r *outboxRepo) CreateOutbox(ctx context.Context, o *models.Outbox) error {
+	return r.do(ctx, func(t *tx) error {
+		if r.findOutbox(o.ID) != -1 {
+			return fmt.Errorf("outbox with id='%s' already exists", o.ID)
+		}
+
+		c := *o
+		// AUTO_INCREMENTと同じく、ロールバックしても連番は戻さない
+		r.outboxSeq++
+		c.Seq = r.outboxSeq
+		r.outboxes = append(r.outboxes, &c)
+		o.Seq = c.Seq
+		t.onRollback(func() { r.outboxes = r.outboxes[:len(r.outboxes)-1] })
+		return nil
+	})
+}
+
+func (r *outboxRepo) ListUnpublishedOutboxes(ctx context.Context, maxAttempts int64, num int64) ([]*models.Outbox, error) {
+	result := make([]*models.Outbox, 0)
+	err := r.do(ctx, func(t *tx) error {
+		// 集約ごとに、未発行の行のうち最後に見たもののseq
+		prev := map[string]int64{}
+		for _, o := range r.outboxes {
+			if int64(len(result)) >= num {
+				break
+			}
+			if o.PublishedAt != nil {
+				continue
+			}
+
+			aggregate := o.AggregateType + "/" + o.AggregateID
+			if o.Attempts < maxAttempts {
+				c := *o
+				if o.AggregateID != "" {
+					c.PrevSeq = prev[aggregate]
+				}
+				result = append(result, &c)
+			}
+			prev[aggregate] = o.Seq
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *outboxRepo) MarkOutboxPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	return r.updateOutbox(ctx, id, func(o *models.Outbox) {
+		o.PublishedAt = &publishedAt
+		o.Attempts++
+		o.LastError = ""
+	})
+}
+
+func (r *outboxRepo) MarkOutboxFailed(ctx context.Context, id string, errMsg string) error {
+	return r.updateOutbox(ctx, id, func(o *models.Outbox) {
+		o.Attempts++
+		o.LastError = errMsg
+	})
+}
+
+func (r *outboxRepo) DeletePublishedOutboxes(ctx context.Context, publishedBefore time.Time, num int64) (int64, error) {
+	var cnt int64
+	err := r.do(ctx, func(t *tx) error {
+		old := r.outboxes
+		list := make([]*models.Outbox, 0, len(old))
+		for _, o := range old {
+			if cnt < num && o.PublishedAt != nil && o.PublishedAt.Before(publishedBefore) {
+				cnt++
+				continue
+			}
+			list = append(list, o)
+		}
+
+		r.outboxes = list
+		t.onRollback(func() { r.outboxes = old })
+		return nil
+	})
+
+	return cnt, err
+}
+
+func (r *outboxRepo) updateOutbox(ctx context.Context, id string, update func(o *models.Outbox)) error {
+	return r.do(ctx, func(t *tx) error {
+		n := r.findOutbox(id)
+		if n == -1 {
+			return fmt.Errorf("expected %d row affected, got %d rows affected", 1, 0)
+		}
+
+		old := r.outboxes[n]
+		c := *old
+		update(&c)
+		r.outboxes[n] = &c
+		t.onRollback(func() { r.outboxes[n] = old })
+		return nil
+	})
+}
+
+func (r *outboxRepo) findOutbox(id string) int {
+	for n, o := range r.outboxes {
+		if o.ID == id {
+			return n
+		}
+	}
+	return -1
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+)
+
+type receivedMessageRepo struct {
+	*Store
+}
+
+func NewReceivedMessageRepo(s *Store) repo.ReceivedMessageRepo {
+	return &receivedMessageRepo{s}
+}
+
+func (r *receivedMessageRepo) ExistsReceivedMessage(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	err := r.do(ctx, func(t *tx) error {
+		_, exists = r.receivedMessages[id]
+		return nil
+	})
+
+	return exists, err
+}
+
+// 既に同じIDのメッセージを処理していればMessageAlreadyReceivedを返す
+func (r *receivedMessageRepo) CreateReceivedMessage(ctx context.Context, m *models.ReceivedMessage) error {
+	return r.do(ctx, func(t *tx) error {
+		if _, ok := r.receivedMessages[m.ID]; ok {
+			return models.NewMessageAlreadyReceivedErr(m.ID)
+		}
+
+		c := *m
+		r.receivedMessages[m.ID] = &c
+		t.onRollback(func() { delete(r.receivedMessages, m.ID) })
+		return nil
+	})
+}
+
+// 件数の上限までは古い順でなくてもよいので、mapの順に消す
+func (r *receivedMessageRepo) DeleteReceivedMessages(ctx context.Context, createdBefore time.Time, num int64) (int64, error) {
+	var cnt int64
+	err := r.do(ctx, func(t *tx) error {
+		for id, m := range r.receivedMessages {
+			if cnt >= num {
+				break
+			}
+			if !m.CreatedAt.Before(createdBefore) {
+				continue
+			}
+
+			m := m
+			delete(r.receivedMessages, id)
+			t.onRollback(func() { r.receivedMessages[m.ID] = m })
+			cnt++
+		}
+		return nil
+	})
+
+	return cnt, err
+}
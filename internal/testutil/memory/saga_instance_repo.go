@@ -0,0 +1,166 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type sagaInstanceRepo struct {
+	*Store
+}
+
+func NewSagaInstanceRepo(s *Store) repo.SagaInstanceRepo {
+	return &sagaInstanceRepo{s}
+}
+
+func (r *sagaInstanceRepo) CreateSagaInstance(ctx context.Context, i *models.SagaInstance) error {
+	return r.do(ctx, func(t *tx) error {
+		if _, ok := r.sagaInstances[i.ID]; ok {
+			return fmt.Errorf("saga_instance with id='%s' already exists", i.ID)
+		}
+
+		c := *i
+		r.sagaInstances[i.ID] = &c
+		t.onRollback(func() { delete(r.sagaInstances, i.ID) })
+		return nil
+	})
+}
+
+// 読み込んだときのversionのままの場合だけ更新する。他で更新されていた場合はSagaInstanceConflictを返す
+func (r *sagaInstanceRepo) UpdateSagaInstance(ctx context.Context, i *models.SagaInstance) error {
+	return r.do(ctx, func(t *tx) error {
+		old, ok := r.sagaInstances[i.ID]
+		if !ok || old.Version != i.Version {
+			return models.NewSagaInstanceConflictErr(i.ID, i.Version)
+		}
+
+		c := *old
+		c.SagaData = i.SagaData
+		c.CurrentState = i.CurrentState
+		c.RejectReason = i.RejectReason
+		c.Version++
+		c.UpdatedAt = i.UpdatedAt
+		r.sagaInstances[i.ID] = &c
+		t.onRollback(func() { r.sagaInstances[i.ID] = old })
+
+		i.Version++
+		return nil
+	})
+}
+
+func (r *sagaInstanceRepo) GetSagaInstance(ctx context.Context, sagaID string) (*models.SagaInstance, error) {
+	var i models.SagaInstance
+	err := r.do(ctx, func(t *tx) error {
+		s, ok := r.sagaInstances[sagaID]
+		if !ok {
+			return status.Errorf(codes.NotFound, "saga_instance with id='%s' is not found", sagaID)
+		}
+		i = *s
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
+
+// 条件に合うサガを更新が古い順に最大num件返す
+func (r *sagaInstanceRepo) listSagaInstances(ctx context.Context, match func(i *models.SagaInstance) bool, num int64) ([]*models.SagaInstance, error) {
+	result := make([]*models.SagaInstance, 0)
+	err := r.do(ctx, func(t *tx) error {
+		for _, s := range r.sagaInstances {
+			if match(s) {
+				i := *s
+				result = append(result, &i)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].UpdatedAt.Before(result[j].UpdatedAt) })
+	if int64(len(result)) > num {
+		result = result[:num]
+	}
+
+	return result, nil
+}
+
+func (r *sagaInstanceRepo) ListSagaInstances(ctx context.Context, f *models.SagaInstanceFilter, num int64) ([]*models.SagaInstance, error) {
+	return r.listSagaInstances(ctx, func(i *models.SagaInstance) bool {
+		return (f.SagaType == "" || i.SagaType == f.SagaType) &&
+			(len(f.States) == 0 || containsString(f.States, i.CurrentState)) &&
+			(f.UpdatedBefore.IsZero() || i.UpdatedAt.Before(f.UpdatedBefore))
+	}, num)
+}
+
+func (r *sagaInstanceRepo) ListStaleSagaInstances(ctx context.Context, sagaType string, states []string, before time.Time, num int64) ([]*models.SagaInstance, error) {
+	return r.listSagaInstances(ctx, func(i *models.SagaInstance) bool {
+		return i.SagaType == sagaType && containsString(states, i.CurrentState) && i.UpdatedAt.Before(before)
+	}, num)
+}
+
+func (r *sagaInstanceRepo) IncrementSagaRetryCount(ctx context.Context, sagaID string, version int64, updatedAt time.Time) error {
+	return r.do(ctx, func(t *tx) error {
+		old, ok := r.sagaInstances[sagaID]
+		if !ok || old.Version != version {
+			return models.NewSagaInstanceConflictErr(sagaID, version)
+		}
+
+		c := *old
+		c.RetryCount++
+		c.Version++
+		c.UpdatedAt = updatedAt
+		r.sagaInstances[sagaID] = &c
+		t.onRollback(func() { r.sagaInstances[sagaID] = old })
+		return nil
+	})
+}
+
+// protojsonはint64を文字列にするので、文字列で比べる
+func (r *sagaInstanceRepo) ExistsUnfinishedSagaInstance(ctx context.Context, sagaType string, finishedStates []string, dataID int64) (bool, error) {
+	var exists bool
+	err := r.do(ctx, func(t *tx) error {
+		for _, s := range r.sagaInstances {
+			if s.SagaType != sagaType || containsString(finishedStates, s.CurrentState) {
+				continue
+			}
+
+			var data struct {
+				ID string `json:"id"`
+			}
+			if err := json.Unmarshal(s.SagaData, &data); err != nil {
+				return err
+			}
+
+			if data.ID == strconv.FormatInt(dataID, 10) {
+				exists = true
+				return nil
+			}
+		}
+		return nil
+	})
+
+	return exists, err
+}
+
+func containsString(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+)
+
+type sagaTransitionRepo struct {
+	*Store
+}
+
+func NewSagaTransitionRepo(s *Store) repo.SagaTransitionRepo {
+	return &sagaTransitionRepo{s}
+}
+
+func (r *sagaTransitionRepo) CreateSagaTransition(ctx context.Context, t *models.SagaTransition) error {
+	return r.do(ctx, func(tx *tx) error {
+		c := *t
+		c.ID = int64(len(r.sagaTransitions)) + 1
+		r.sagaTransitions = append(r.sagaTransitions, &c)
+		tx.onRollback(func() { r.sagaTransitions = r.sagaTransitions[:len(r.sagaTransitions)-1] })
+
+		t.ID = c.ID
+		return nil
+	})
+}
+
+func (r *sagaTransitionRepo) ListSagaTransitions(ctx context.Context, sagaID string) ([]*models.SagaTransition, error) {
+	result := make([]*models.SagaTransition, 0)
+	err := r.do(ctx, func(tx *tx) error {
+		for _, t := range r.sagaTransitions {
+			if t.SagaID == sagaID {
+				c := *t
+				result = append(result, &c)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
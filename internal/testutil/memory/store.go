@@ -0,0 +1,89 @@
+// テスト用に、サガとoutboxのリポジトリをメモリ上で実装する
+// トランザクションは1つずつしか開けないので、トランザクション同士はSERIALIZABLEと同じになる
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ezio1119/fishapp-post/models"
+)
+
+type contextKey string
+
+const txCtxKey contextKey = "memoryTx"
+
+// 全てのリポジトリで共有するテーブル
+type Store struct {
+	mu               sync.Mutex
+	sagaInstances    map[string]*models.SagaInstance
+	sagaTransitions  []*models.SagaTransition
+	outboxes         []*models.Outbox
+	outboxSeq        int64
+	receivedMessages map[string]*models.ReceivedMessage
+}
+
+func NewStore() *Store {
+	return &Store{
+		sagaInstances:    map[string]*models.SagaInstance{},
+		sagaTransitions:  []*models.SagaTransition{},
+		outboxes:         []*models.Outbox{},
+		receivedMessages: map[string]*models.ReceivedMessage{},
+	}
+}
+
+// トランザクションの間はStoreのロックを持ち続ける。ロールバックではundoを逆順に呼んで戻す
+type tx struct {
+	undo []func()
+	done bool
+}
+
+func (t *tx) onRollback(f func()) {
+	if t != nil {
+		t.undo = append(t.undo, f)
+	}
+}
+
+func txFromCtx(ctx context.Context) *tx {
+	t, _ := ctx.Value(txCtxKey).(*tx)
+	return t
+}
+
+// ctxがトランザクションの中ならロックを持っているのでそのまま呼び、外ならその間だけロックする
+func (s *Store) do(ctx context.Context, f func(t *tx) error) error {
+	if t := txFromCtx(ctx); t != nil {
+		return f(t)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return f(nil)
+}
+
+func (s *Store) beginTx(ctx context.Context) (context.Context, error) {
+	if txFromCtx(ctx) != nil {
+		return nil, errors.New("transaction is already begun")
+	}
+
+	s.mu.Lock()
+	return context.WithValue(ctx, txCtxKey, &tx{}), nil
+}
+
+func (s *Store) endTx(ctx context.Context, rollback bool) (context.Context, error) {
+	t := txFromCtx(ctx)
+	if t == nil || t.done {
+		return ctx, errors.New("transaction has already been committed or rolled back")
+	}
+
+	if rollback {
+		for n := len(t.undo) - 1; n >= 0; n-- {
+			t.undo[n]()
+		}
+	}
+
+	t.done = true
+	s.mu.Unlock()
+
+	return context.WithValue(ctx, txCtxKey, (*tx)(nil)), nil
+}
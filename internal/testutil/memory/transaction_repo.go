@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+)
+
+type transactionRepo struct {
+	*Store
+}
+
+func NewTransactionRepo(s *Store) repo.TransactionRepo {
+	return &transactionRepo{s}
+}
+
+func (r *transactionRepo) BeginTx(ctx context.Context) (context.Context, error) {
+	return r.Store.beginTx(ctx)
+}
+
+func (r *transactionRepo) Roolback(ctx context.Context) (context.Context, error) {
+	return r.Store.endTx(ctx, true)
+}
+
+func (r *transactionRepo) Commit(ctx context.Context) (context.Context, error) {
+	return r.Store.endTx(ctx, false)
+}
@@ -38,15 +38,50 @@ func main() {
 	defer grpcConn.Close()
 	imageC := pb.NewImageServiceClient(grpcConn)
 
+	chatConn, err := grpc.DialContext(ctx, conf.C.API.ChatURL, grpc.WithInsecure())
+	if err != nil {
+		panic(err)
+	}
+	defer chatConn.Close()
+	chatC := pb.NewChatServiceClient(chatConn)
+
 	ctxTimeout := time.Duration(conf.C.Sv.Timeout) * time.Second
 	sqlHandler := sqlhandler.NewSqlHandler(dbConn)
 
-	createPostSagaManager := saga.InitCreatePostSagaManager(
+	sagaOrchestrator := saga.NewOrchestrator(
 		repo.NewOutboxRepo(sqlHandler),
-		repo.NewPostRepo(sqlHandler),
 		repo.NewSagaInstanceRepo(sqlHandler),
+		repo.NewSagaTransitionRepo(sqlHandler),
+		repo.NewReceivedMessageRepo(sqlHandler),
 		repo.NewTransactionRepo(sqlHandler),
 	)
+	sagaOrchestrator.Register(saga.NewCreatePostSagaDefinition(
+		repo.NewPostRepo(sqlHandler),
+		repo.NewImageRepo(imageC),
+		repo.NewOutboxRepo(sqlHandler),
+	))
+	sagaOrchestrator.Register(saga.NewDeletePostSagaDefinition(
+		repo.NewPostRepo(sqlHandler),
+		repo.NewImageRepo(imageC),
+	))
+	sagaOrchestrator.Register(saga.NewApplyPostSagaDefinition(
+		repo.NewApplyPostRepo(sqlHandler),
+		repo.NewChatRepo(chatC),
+	))
+	sagaOrchestrator.Register(saga.NewDeleteApplyPostSagaDefinition(
+		repo.NewPostRepo(sqlHandler),
+		repo.NewApplyPostRepo(sqlHandler),
+		repo.NewOutboxRepo(sqlHandler),
+		repo.NewChatRepo(chatC),
+	))
+
+	sInteractor := interactor.NewSagaInteractor(
+		sagaOrchestrator,
+		repo.NewSagaInstanceRepo(sqlHandler),
+		repo.NewSagaTransitionRepo(sqlHandler),
+		ctxTimeout,
+		time.Duration(conf.C.Saga.WatchInterval)*time.Millisecond,
+	)
 
 	pController := controllers.NewPostController(
 		interactor.NewPostInteractor(
@@ -55,19 +90,34 @@ func main() {
 			repo.NewApplyPostRepo(sqlHandler),
 			repo.NewTransactionRepo(sqlHandler),
 			repo.NewOutboxRepo(sqlHandler),
-			createPostSagaManager,
+			sagaOrchestrator,
 			ctxTimeout,
-		))
+		),
+		sInteractor,
+	)
 
 	server := infrastructure.NewGrpcServer(
 		middleware.InitMiddleware(),
 		pController,
 	)
 
+	adminServer := infrastructure.NewAdminGrpcServer(
+		middleware.InitMiddleware(),
+		controllers.NewPostAdminController(
+			sInteractor,
+			interactor.NewSagaAdminInteractor(
+				sagaOrchestrator,
+				repo.NewSagaInstanceRepo(sqlHandler),
+				ctxTimeout,
+			),
+		),
+	)
+
 	rController := controllers.NewSagaReplyController(
 		interactor.NewSagaReplyInteractor(
-			createPostSagaManager,
+			sagaOrchestrator,
 			repo.NewSagaInstanceRepo(sqlHandler),
+			repo.NewReceivedMessageRepo(sqlHandler),
 		),
 	)
 
@@ -75,6 +125,50 @@ func main() {
 		panic(err)
 	}
 
+	if err := infrastructure.StartSubscribeDeletePostSagaReply(natsConn, rController); err != nil {
+		panic(err)
+	}
+
+	oInteractor := interactor.NewOutboxInteractor(
+		repo.NewOutboxRepo(sqlHandler),
+		repo.NewReceivedMessageRepo(sqlHandler),
+		repo.NewEventRepo(natsConn),
+		repo.NewTransactionRepo(sqlHandler),
+		ctxTimeout,
+	)
+
+	infrastructure.StartOutboxRelay(oInteractor)
+	infrastructure.StartOutboxPurge(oInteractor)
+
+	infrastructure.StartPostCloser(
+		dbConn,
+		interactor.NewPostCloseInteractor(
+			repo.NewPostRepo(sqlHandler),
+			repo.NewOutboxRepo(sqlHandler),
+			repo.NewTransactionRepo(sqlHandler),
+			ctxTimeout,
+		),
+	)
+
+	infrastructure.StartSagaTimeoutSweeper(
+		interactor.NewSagaTimeoutInteractor(
+			sagaOrchestrator,
+			repo.NewSagaInstanceRepo(sqlHandler),
+			ctxTimeout,
+		),
+	)
+
+	adminList, err := net.Listen("tcp", ":"+conf.C.Sv.AdminPort)
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		if err := adminServer.Serve(adminList); err != nil {
+			panic(err)
+		}
+	}()
+
 	list, err := net.Listen("tcp", ":"+conf.C.Sv.Port)
 	if err != nil {
 		panic(err)
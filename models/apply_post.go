@@ -6,6 +6,30 @@ type ApplyPost struct {
 	ID        int64
 	UserID    int64
 	PostID    int64
+	Status    string
+	PartySize int64 // 応募者本人を含めた人数
+	Note      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// 応募のステータス。max_applyに数えるのはacceptedだけ
+// 定員に達してから応募するとwaitlistedになり、空きができたら古い順にpendingに繰り上がる
+const (
+	ApplyPostStatusPending    = "pending"
+	ApplyPostStatusAccepted   = "accepted"
+	ApplyPostStatusDeclined   = "declined"
+	ApplyPostStatusWithdrawn  = "withdrawn"
+	ApplyPostStatusWaitlisted = "waitlisted"
+)
+
+// 先頭から順に、合計人数がseatsに収まる応募を返す。順番を守るため、収まらない応募があったらそこで止める
+func TakeApplyPostsWithinSeats(list []*ApplyPost, seats int64) []*ApplyPost {
+	for i, a := range list {
+		if seats < a.PartySize {
+			return list[:i]
+		}
+		seats -= a.PartySize
+	}
+	return list
+}
@@ -0,0 +1,23 @@
+package models
+
+import "context"
+
+// リクエストやイベントをまたいで引き継ぐ、トレース用のメタデータ
+type EventMetadata struct {
+	CorrelationID string
+	CausationID   string
+	UserID        int64
+}
+
+type eventMetadataCtxKey struct{}
+
+func ContextWithEventMetadata(ctx context.Context, m *EventMetadata) context.Context {
+	return context.WithValue(ctx, eventMetadataCtxKey{}, m)
+}
+
+func EventMetadataFromContext(ctx context.Context) *EventMetadata {
+	if m, ok := ctx.Value(eventMetadataCtxKey{}).(*EventMetadata); ok {
+		return m
+	}
+	return &EventMetadata{}
+}
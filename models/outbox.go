@@ -1,14 +1,56 @@
 package models
 
-import "time"
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	OutboxSchemaVersion = 1
+	OutboxProducer      = "fishapp-post"
+)
 
 type Outbox struct {
 	ID            string
+	Seq           int64 // 書き込んだ順の連番。同じ集約のイベントはこの順に発行する
 	EventType     string
 	EventData     []byte
 	AggregateID   string
 	AggregateType string
 	Channel       string
+	SchemaVersion int64
+	CorrelationID string
+	CausationID   string
+	Producer      string
+	UserID        int64
+	OccurredAt    time.Time
+	PublishedAt   *time.Time
+	Attempts      int64
+	PrevSeq       int64 // 同じ集約の未発行の行のうち、直前の行のseq。無ければ0
+	LastError     string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+// ctxに入っているEventMetadataをエンベロープとして詰めたOutboxを作る
+func NewOutbox(ctx context.Context, eventType string, channel string, eventData []byte) *Outbox {
+	now := time.Now()
+	m := EventMetadataFromContext(ctx)
+
+	return &Outbox{
+		ID:            uuid.New().String(),
+		EventType:     eventType,
+		EventData:     eventData,
+		Channel:       channel,
+		SchemaVersion: OutboxSchemaVersion,
+		CorrelationID: m.CorrelationID,
+		CausationID:   m.CausationID,
+		Producer:      OutboxProducer,
+		UserID:        m.UserID,
+		OccurredAt:    now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
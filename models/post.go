@@ -13,12 +13,25 @@ type Post struct {
 	PrefectureID      int64
 	MeetingPlaceID    string
 	MeetingAt         time.Time
+	ApplyDeadline     time.Time
 	MaxApply          int64
 	UserID            int64
+	Status            string
+	CancelReason      string
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 }
 
+// 投稿のステータス。サガのステートの遷移に合わせて変わる
+const (
+	PostStatusPending   = "pending"
+	PostStatusPublished = "published"
+	PostStatusRejected  = "rejected"
+	PostStatusClosed    = "closed"
+	PostStatusCancelled = "cancelled"
+	PostStatusDraft     = "draft"
+)
+
 type orderBy int64
 
 const (
@@ -60,4 +73,6 @@ type PostFilter struct {
 	SortBy        sortBy
 	FishTypeIDs   []int64
 	CanApply      bool
+	Statuses      []string // 空の場合はステータスで絞り込まない
+	Draft         bool
 }
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type ReceivedMessage struct {
+	ID        string
+	EventType string
+	CreatedAt time.Time
+}
+
+type MessageAlreadyReceived struct {
+	ID string
+}
+
+func NewMessageAlreadyReceivedErr(id string) error {
+	return &MessageAlreadyReceived{id}
+}
+
+func (e *MessageAlreadyReceived) Error() string {
+	return fmt.Sprintf("message id=%s is already received", e.ID)
+}
@@ -1,12 +1,40 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type SagaInstance struct {
 	ID           string
 	SagaType     string
 	SagaData     []byte
 	CurrentState string
+	RetryCount   int64
+	RejectReason string
+	Version      int64
+	Finished     bool // 保存せずに、読み込んだ後でサガの定義から決める
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
+
+// 空のフィールドでは絞り込まない
+type SagaInstanceFilter struct {
+	SagaType      string
+	States        []string
+	UpdatedBefore time.Time
+}
+
+// 読み込んでから更新するまでに、他で更新されていた場合のエラー
+type SagaInstanceConflict struct {
+	ID      string
+	Version int64
+}
+
+func NewSagaInstanceConflictErr(id string, version int64) error {
+	return &SagaInstanceConflict{id, version}
+}
+
+func (e *SagaInstanceConflict) Error() string {
+	return fmt.Sprintf("saga_instance id=%s with version=%d was updated by another process", e.ID, e.Version)
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// サガのステート遷移1回分の履歴
+type SagaTransition struct {
+	ID           int64
+	SagaID       string
+	Event        string
+	FromState    string
+	ToState      string
+	Payload      []byte
+	ErrorMessage string
+	CreatedAt    time.Time
+}
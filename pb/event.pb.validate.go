@@ -75,6 +75,26 @@ func (m *Event) Validate() error {
 		}
 	}
 
+	// no validation rules for SchemaVersion
+
+	// no validation rules for CorrelationId
+
+	// no validation rules for CausationId
+
+	// no validation rules for Producer
+
+	// no validation rules for UserId
+
+	if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -132,6 +152,88 @@ var _ interface {
 	ErrorName() string
 } = EventValidationError{}
 
+// Validate checks the field values on DeadLetter with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *DeadLetter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Channel
+
+	// no validation rules for Data
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for RedeliveryCount
+
+	if v, ok := interface{}(m.GetFailedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "FailedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeadLetterValidationError is the validation error returned by
+// DeadLetter.Validate if the designated constraints aren't met.
+type DeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterValidationError) ErrorName() string { return "DeadLetterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterValidationError{}
+
 // Validate checks the field values on RoomCreated with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -358,30 +460,28 @@ var _ interface {
 	ErrorName() string
 } = CreateRoomValidationError{}
 
-// Validate checks the field values on PostDeleted with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *PostDeleted) Validate() error {
+// Validate checks the field values on DeleteRoom with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *DeleteRoom) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostDeletedValidationError{
-				field:  "Post",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	// no validation rules for SagaId
+
+	if m.GetPostId() < 1 {
+		return DeleteRoomValidationError{
+			field:  "PostId",
+			reason: "value must be greater than or equal to 1",
 		}
 	}
 
 	return nil
 }
 
-// PostDeletedValidationError is the validation error returned by
-// PostDeleted.Validate if the designated constraints aren't met.
-type PostDeletedValidationError struct {
+// DeleteRoomValidationError is the validation error returned by
+// DeleteRoom.Validate if the designated constraints aren't met.
+type DeleteRoomValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -389,22 +489,22 @@ type PostDeletedValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostDeletedValidationError) Field() string { return e.field }
+func (e DeleteRoomValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostDeletedValidationError) Reason() string { return e.reason }
+func (e DeleteRoomValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostDeletedValidationError) Cause() error { return e.cause }
+func (e DeleteRoomValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostDeletedValidationError) Key() bool { return e.key }
+func (e DeleteRoomValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostDeletedValidationError) ErrorName() string { return "PostDeletedValidationError" }
+func (e DeleteRoomValidationError) ErrorName() string { return "DeleteRoomValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PostDeletedValidationError) Error() string {
+func (e DeleteRoomValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -416,14 +516,14 @@ func (e PostDeletedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostDeleted.%s: %s%s",
+		"invalid %sDeleteRoom.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostDeletedValidationError{}
+var _ error = DeleteRoomValidationError{}
 
 var _ interface {
 	Field() string
@@ -431,36 +531,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostDeletedValidationError{}
+} = DeleteRoomValidationError{}
 
-// Validate checks the field values on PostRejected with the rules defined in
+// Validate checks the field values on RoomDeleted with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
-func (m *PostRejected) Validate() error {
+func (m *RoomDeleted) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for SagaId
 
-	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostRejectedValidationError{
-				field:  "Post",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for ErrorMessage
-
 	return nil
 }
 
-// PostRejectedValidationError is the validation error returned by
-// PostRejected.Validate if the designated constraints aren't met.
-type PostRejectedValidationError struct {
+// RoomDeletedValidationError is the validation error returned by
+// RoomDeleted.Validate if the designated constraints aren't met.
+type RoomDeletedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -468,22 +556,22 @@ type PostRejectedValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostRejectedValidationError) Field() string { return e.field }
+func (e RoomDeletedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostRejectedValidationError) Reason() string { return e.reason }
+func (e RoomDeletedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostRejectedValidationError) Cause() error { return e.cause }
+func (e RoomDeletedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostRejectedValidationError) Key() bool { return e.key }
+func (e RoomDeletedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostRejectedValidationError) ErrorName() string { return "PostRejectedValidationError" }
+func (e RoomDeletedValidationError) ErrorName() string { return "RoomDeletedValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PostRejectedValidationError) Error() string {
+func (e RoomDeletedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -495,14 +583,14 @@ func (e PostRejectedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostRejected.%s: %s%s",
+		"invalid %sRoomDeleted.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostRejectedValidationError{}
+var _ error = RoomDeletedValidationError{}
 
 var _ interface {
 	Field() string
@@ -510,34 +598,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostRejectedValidationError{}
+} = RoomDeletedValidationError{}
 
-// Validate checks the field values on PostApproved with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *PostApproved) Validate() error {
+// Validate checks the field values on DeleteRoomFailed with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DeleteRoomFailed) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for SagaId
 
-	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostApprovedValidationError{
-				field:  "Post",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Message
 
 	return nil
 }
 
-// PostApprovedValidationError is the validation error returned by
-// PostApproved.Validate if the designated constraints aren't met.
-type PostApprovedValidationError struct {
+// DeleteRoomFailedValidationError is the validation error returned by
+// DeleteRoomFailed.Validate if the designated constraints aren't met.
+type DeleteRoomFailedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -545,22 +625,22 @@ type PostApprovedValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostApprovedValidationError) Field() string { return e.field }
+func (e DeleteRoomFailedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostApprovedValidationError) Reason() string { return e.reason }
+func (e DeleteRoomFailedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostApprovedValidationError) Cause() error { return e.cause }
+func (e DeleteRoomFailedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostApprovedValidationError) Key() bool { return e.key }
+func (e DeleteRoomFailedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostApprovedValidationError) ErrorName() string { return "PostApprovedValidationError" }
+func (e DeleteRoomFailedValidationError) ErrorName() string { return "DeleteRoomFailedValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PostApprovedValidationError) Error() string {
+func (e DeleteRoomFailedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -572,14 +652,14 @@ func (e PostApprovedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostApproved.%s: %s%s",
+		"invalid %sDeleteRoomFailed.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostApprovedValidationError{}
+var _ error = DeleteRoomFailedValidationError{}
 
 var _ interface {
 	Field() string
@@ -587,20 +667,20 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostApprovedValidationError{}
+} = DeleteRoomFailedValidationError{}
 
-// Validate checks the field values on ApplyPostCreated with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ApplyPostCreated) Validate() error {
+// Validate checks the field values on PostCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PostCreated) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetApplyPost()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ApplyPostCreatedValidationError{
-				field:  "ApplyPost",
+			return PostCreatedValidationError{
+				field:  "Post",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -610,9 +690,9 @@ func (m *ApplyPostCreated) Validate() error {
 	return nil
 }
 
-// ApplyPostCreatedValidationError is the validation error returned by
-// ApplyPostCreated.Validate if the designated constraints aren't met.
-type ApplyPostCreatedValidationError struct {
+// PostCreatedValidationError is the validation error returned by
+// PostCreated.Validate if the designated constraints aren't met.
+type PostCreatedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -620,22 +700,22 @@ type ApplyPostCreatedValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplyPostCreatedValidationError) Field() string { return e.field }
+func (e PostCreatedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplyPostCreatedValidationError) Reason() string { return e.reason }
+func (e PostCreatedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplyPostCreatedValidationError) Cause() error { return e.cause }
+func (e PostCreatedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplyPostCreatedValidationError) Key() bool { return e.key }
+func (e PostCreatedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplyPostCreatedValidationError) ErrorName() string { return "ApplyPostCreatedValidationError" }
+func (e PostCreatedValidationError) ErrorName() string { return "PostCreatedValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ApplyPostCreatedValidationError) Error() string {
+func (e PostCreatedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -647,14 +727,14 @@ func (e ApplyPostCreatedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplyPostCreated.%s: %s%s",
+		"invalid %sPostCreated.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplyPostCreatedValidationError{}
+var _ error = PostCreatedValidationError{}
 
 var _ interface {
 	Field() string
@@ -662,20 +742,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplyPostCreatedValidationError{}
+} = PostCreatedValidationError{}
 
-// Validate checks the field values on ApplyPostDeleted with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ApplyPostDeleted) Validate() error {
+// Validate checks the field values on PostUpdated with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PostUpdated) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetApplyPost()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ApplyPostDeletedValidationError{
-				field:  "ApplyPost",
+			return PostUpdatedValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostUpdatedValidationError{
+				field:  "After",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -685,9 +775,9 @@ func (m *ApplyPostDeleted) Validate() error {
 	return nil
 }
 
-// ApplyPostDeletedValidationError is the validation error returned by
-// ApplyPostDeleted.Validate if the designated constraints aren't met.
-type ApplyPostDeletedValidationError struct {
+// PostUpdatedValidationError is the validation error returned by
+// PostUpdated.Validate if the designated constraints aren't met.
+type PostUpdatedValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -695,22 +785,22 @@ type ApplyPostDeletedValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplyPostDeletedValidationError) Field() string { return e.field }
+func (e PostUpdatedValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplyPostDeletedValidationError) Reason() string { return e.reason }
+func (e PostUpdatedValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplyPostDeletedValidationError) Cause() error { return e.cause }
+func (e PostUpdatedValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplyPostDeletedValidationError) Key() bool { return e.key }
+func (e PostUpdatedValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplyPostDeletedValidationError) ErrorName() string { return "ApplyPostDeletedValidationError" }
+func (e PostUpdatedValidationError) ErrorName() string { return "PostUpdatedValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ApplyPostDeletedValidationError) Error() string {
+func (e PostUpdatedValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -722,14 +812,14 @@ func (e ApplyPostDeletedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplyPostDeleted.%s: %s%s",
+		"invalid %sPostUpdated.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplyPostDeletedValidationError{}
+var _ error = PostUpdatedValidationError{}
 
 var _ interface {
 	Field() string
@@ -737,4 +827,925 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplyPostDeletedValidationError{}
+} = PostUpdatedValidationError{}
+
+// Validate checks the field values on PostDeleted with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PostDeleted) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostDeletedValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PostDeletedValidationError is the validation error returned by
+// PostDeleted.Validate if the designated constraints aren't met.
+type PostDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostDeletedValidationError) ErrorName() string { return "PostDeletedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostDeletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostDeletedValidationError{}
+
+// Validate checks the field values on PostClosed with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *PostClosed) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostClosedValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PostClosedValidationError is the validation error returned by
+// PostClosed.Validate if the designated constraints aren't met.
+type PostClosedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostClosedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostClosedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostClosedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostClosedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostClosedValidationError) ErrorName() string { return "PostClosedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostClosedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostClosed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostClosedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostClosedValidationError{}
+
+// Validate checks the field values on PostCancelled with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PostCancelled) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostCancelledValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// PostCancelledValidationError is the validation error returned by
+// PostCancelled.Validate if the designated constraints aren't met.
+type PostCancelledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostCancelledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostCancelledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostCancelledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostCancelledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostCancelledValidationError) ErrorName() string { return "PostCancelledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostCancelledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostCancelled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostCancelledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostCancelledValidationError{}
+
+// Validate checks the field values on PostRejected with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PostRejected) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SagaId
+
+	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostRejectedValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ErrorMessage
+
+	return nil
+}
+
+// PostRejectedValidationError is the validation error returned by
+// PostRejected.Validate if the designated constraints aren't met.
+type PostRejectedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostRejectedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostRejectedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostRejectedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostRejectedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostRejectedValidationError) ErrorName() string { return "PostRejectedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostRejectedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostRejected.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostRejectedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostRejectedValidationError{}
+
+// Validate checks the field values on PostDeleteRejected with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PostDeleteRejected) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SagaId
+
+	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostDeleteRejectedValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ErrorMessage
+
+	return nil
+}
+
+// PostDeleteRejectedValidationError is the validation error returned by
+// PostDeleteRejected.Validate if the designated constraints aren't met.
+type PostDeleteRejectedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostDeleteRejectedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostDeleteRejectedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostDeleteRejectedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostDeleteRejectedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostDeleteRejectedValidationError) ErrorName() string {
+	return "PostDeleteRejectedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostDeleteRejectedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostDeleteRejected.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostDeleteRejectedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostDeleteRejectedValidationError{}
+
+// Validate checks the field values on PostApproved with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PostApproved) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SagaId
+
+	if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostApprovedValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PostApprovedValidationError is the validation error returned by
+// PostApproved.Validate if the designated constraints aren't met.
+type PostApprovedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostApprovedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostApprovedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostApprovedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostApprovedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostApprovedValidationError) ErrorName() string { return "PostApprovedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostApprovedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostApproved.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostApprovedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostApprovedValidationError{}
+
+// Validate checks the field values on ApplyPostCreated with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ApplyPostCreated) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApplyPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyPostCreatedValidationError{
+				field:  "ApplyPost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyPostCreatedValidationError is the validation error returned by
+// ApplyPostCreated.Validate if the designated constraints aren't met.
+type ApplyPostCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPostCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPostCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPostCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPostCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPostCreatedValidationError) ErrorName() string { return "ApplyPostCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApplyPostCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPostCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPostCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPostCreatedValidationError{}
+
+// Validate checks the field values on ApplyPostDeleted with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ApplyPostDeleted) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApplyPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyPostDeletedValidationError{
+				field:  "ApplyPost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyPostDeletedValidationError is the validation error returned by
+// ApplyPostDeleted.Validate if the designated constraints aren't met.
+type ApplyPostDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPostDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPostDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPostDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPostDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPostDeletedValidationError) ErrorName() string { return "ApplyPostDeletedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApplyPostDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPostDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPostDeletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPostDeletedValidationError{}
+
+// Validate checks the field values on ApplyPostAccepted with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ApplyPostAccepted) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApplyPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyPostAcceptedValidationError{
+				field:  "ApplyPost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyPostAcceptedValidationError is the validation error returned by
+// ApplyPostAccepted.Validate if the designated constraints aren't met.
+type ApplyPostAcceptedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPostAcceptedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPostAcceptedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPostAcceptedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPostAcceptedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPostAcceptedValidationError) ErrorName() string {
+	return "ApplyPostAcceptedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPostAcceptedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPostAccepted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPostAcceptedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPostAcceptedValidationError{}
+
+// Validate checks the field values on ApplyPostDeclined with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ApplyPostDeclined) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApplyPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyPostDeclinedValidationError{
+				field:  "ApplyPost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyPostDeclinedValidationError is the validation error returned by
+// ApplyPostDeclined.Validate if the designated constraints aren't met.
+type ApplyPostDeclinedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPostDeclinedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPostDeclinedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPostDeclinedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPostDeclinedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPostDeclinedValidationError) ErrorName() string {
+	return "ApplyPostDeclinedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPostDeclinedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPostDeclined.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPostDeclinedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPostDeclinedValidationError{}
+
+// Validate checks the field values on ApplyPostWithdrawn with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplyPostWithdrawn) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApplyPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyPostWithdrawnValidationError{
+				field:  "ApplyPost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyPostWithdrawnValidationError is the validation error returned by
+// ApplyPostWithdrawn.Validate if the designated constraints aren't met.
+type ApplyPostWithdrawnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPostWithdrawnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPostWithdrawnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPostWithdrawnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPostWithdrawnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPostWithdrawnValidationError) ErrorName() string {
+	return "ApplyPostWithdrawnValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPostWithdrawnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPostWithdrawn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPostWithdrawnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPostWithdrawnValidationError{}
+
+// Validate checks the field values on ApplyPostPromoted with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ApplyPostPromoted) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApplyPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyPostPromotedValidationError{
+				field:  "ApplyPost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyPostPromotedValidationError is the validation error returned by
+// ApplyPostPromoted.Validate if the designated constraints aren't met.
+type ApplyPostPromotedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPostPromotedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPostPromotedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPostPromotedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPostPromotedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPostPromotedValidationError) ErrorName() string {
+	return "ApplyPostPromotedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPostPromotedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPostPromoted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPostPromotedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPostPromotedValidationError{}
@@ -32,6 +32,119 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type Post_Status int32
+
+const (
+	Post_PENDING   Post_Status = 0 // サガが終わるまで。投稿者以外には見えない
+	Post_PUBLISHED Post_Status = 1
+	Post_REJECTED  Post_Status = 2
+	Post_CLOSED    Post_Status = 3
+	Post_CANCELLED Post_Status = 4
+	Post_DRAFT     Post_Status = 5 // 公開するまで投稿者本人にしか見えない。サガは始まっていない
+)
+
+// Enum value maps for Post_Status.
+var (
+	Post_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "PUBLISHED",
+		2: "REJECTED",
+		3: "CLOSED",
+		4: "CANCELLED",
+		5: "DRAFT",
+	}
+	Post_Status_value = map[string]int32{
+		"PENDING":   0,
+		"PUBLISHED": 1,
+		"REJECTED":  2,
+		"CLOSED":    3,
+		"CANCELLED": 4,
+		"DRAFT":     5,
+	}
+)
+
+func (x Post_Status) Enum() *Post_Status {
+	p := new(Post_Status)
+	*p = x
+	return p
+}
+
+func (x Post_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Post_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[0].Descriptor()
+}
+
+func (Post_Status) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[0]
+}
+
+func (x Post_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Post_Status.Descriptor instead.
+func (Post_Status) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type ApplyPost_Status int32
+
+const (
+	ApplyPost_PENDING    ApplyPost_Status = 0 // 投稿者が承認するまで。max_applyには数えない
+	ApplyPost_ACCEPTED   ApplyPost_Status = 1
+	ApplyPost_DECLINED   ApplyPost_Status = 2
+	ApplyPost_WITHDRAWN  ApplyPost_Status = 3 // 応募者が取り消した
+	ApplyPost_WAITLISTED ApplyPost_Status = 4 // 定員に達していたので空き待ち。空いたら古い順にpendingに繰り上げる
+)
+
+// Enum value maps for ApplyPost_Status.
+var (
+	ApplyPost_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "ACCEPTED",
+		2: "DECLINED",
+		3: "WITHDRAWN",
+		4: "WAITLISTED",
+	}
+	ApplyPost_Status_value = map[string]int32{
+		"PENDING":    0,
+		"ACCEPTED":   1,
+		"DECLINED":   2,
+		"WITHDRAWN":  3,
+		"WAITLISTED": 4,
+	}
+)
+
+func (x ApplyPost_Status) Enum() *ApplyPost_Status {
+	p := new(ApplyPost_Status)
+	*p = x
+	return p
+}
+
+func (x ApplyPost_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplyPost_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_post_proto_enumTypes[1].Descriptor()
+}
+
+func (ApplyPost_Status) Type() protoreflect.EnumType {
+	return &file_post_proto_enumTypes[1]
+}
+
+func (x ApplyPost_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplyPost_Status.Descriptor instead.
+func (ApplyPost_Status) EnumDescriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type ListPostsReq_Filter_OrderBy int32
 
 const (
@@ -62,11 +175,11 @@ func (x ListPostsReq_Filter_OrderBy) String() string {
 }
 
 func (ListPostsReq_Filter_OrderBy) Descriptor() protoreflect.EnumDescriptor {
-	return file_post_proto_enumTypes[0].Descriptor()
+	return file_post_proto_enumTypes[2].Descriptor()
 }
 
 func (ListPostsReq_Filter_OrderBy) Type() protoreflect.EnumType {
-	return &file_post_proto_enumTypes[0]
+	return &file_post_proto_enumTypes[2]
 }
 
 func (x ListPostsReq_Filter_OrderBy) Number() protoreflect.EnumNumber {
@@ -108,11 +221,11 @@ func (x ListPostsReq_Filter_SortBy) String() string {
 }
 
 func (ListPostsReq_Filter_SortBy) Descriptor() protoreflect.EnumDescriptor {
-	return file_post_proto_enumTypes[1].Descriptor()
+	return file_post_proto_enumTypes[3].Descriptor()
 }
 
 func (ListPostsReq_Filter_SortBy) Type() protoreflect.EnumType {
-	return &file_post_proto_enumTypes[1]
+	return &file_post_proto_enumTypes[3]
 }
 
 func (x ListPostsReq_Filter_SortBy) Number() protoreflect.EnumNumber {
@@ -141,6 +254,9 @@ type Post struct {
 	MaxApply          int64                `protobuf:"varint,10,opt,name=max_apply,json=maxApply,proto3" json:"max_apply,omitempty"`
 	CreatedAt         *timestamp.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         *timestamp.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status            Post_Status          `protobuf:"varint,13,opt,name=status,proto3,enum=post.Post_Status" json:"status,omitempty"`
+	CancelReason      string               `protobuf:"bytes,14,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`    // cancelledの場合のみ
+	ApplyDeadline     *timestamp.Timestamp `protobuf:"bytes,15,opt,name=apply_deadline,json=applyDeadline,proto3" json:"apply_deadline,omitempty"` // これを過ぎると応募も応募の取り消しもできない
 }
 
 func (x *Post) Reset() {
@@ -259,6 +375,27 @@ func (x *Post) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *Post) GetStatus() Post_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Post_PENDING
+}
+
+func (x *Post) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *Post) GetApplyDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.ApplyDeadline
+	}
+	return nil
+}
+
 type ApplyPost struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -269,6 +406,9 @@ type ApplyPost struct {
 	UserId    int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status    ApplyPost_Status     `protobuf:"varint,6,opt,name=status,proto3,enum=post.ApplyPost_Status" json:"status,omitempty"`
+	PartySize int64                `protobuf:"varint,7,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"` // 応募者本人を含めた人数。max_applyにはこの人数で数える
+	Note      string               `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`                             // 投稿者へのメッセージ
 }
 
 func (x *ApplyPost) Reset() {
@@ -338,12 +478,34 @@ func (x *ApplyPost) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *ApplyPost) GetStatus() ApplyPost_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ApplyPost_PENDING
+}
+
+func (x *ApplyPost) GetPartySize() int64 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *ApplyPost) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 type GetPostReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequesterId int64 `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` // 投稿者本人の場合は公開されていない投稿も返す
 }
 
 func (x *GetPostReq) Reset() {
@@ -385,6 +547,13 @@ func (x *GetPostReq) GetId() int64 {
 	return 0
 }
 
+func (x *GetPostReq) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
 type ListPostsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -611,6 +780,8 @@ type CreatePostReqInfo struct {
 	MeetingAt         *timestamp.Timestamp `protobuf:"bytes,7,opt,name=meeting_at,json=meetingAt,proto3" json:"meeting_at,omitempty"`
 	MaxApply          int64                `protobuf:"varint,8,opt,name=max_apply,json=maxApply,proto3" json:"max_apply,omitempty"`
 	UserId            int64                `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ApplyDeadline     *timestamp.Timestamp `protobuf:"bytes,10,opt,name=apply_deadline,json=applyDeadline,proto3" json:"apply_deadline,omitempty"` // meeting_at以前。空の場合はmeeting_at
+	Draft             bool                 `protobuf:"varint,11,opt,name=draft,proto3" json:"draft,omitempty"`                                     // trueの場合は下書きとして保存して、PublishPostするまで公開しない
 }
 
 func (x *CreatePostReqInfo) Reset() {
@@ -708,6 +879,20 @@ func (x *CreatePostReqInfo) GetUserId() int64 {
 	return 0
 }
 
+func (x *CreatePostReqInfo) GetApplyDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.ApplyDeadline
+	}
+	return nil
+}
+
+func (x *CreatePostReqInfo) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
 type CreatePostRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -778,6 +963,7 @@ type UpdatePostReqInfo struct {
 	MeetingAt         *timestamp.Timestamp `protobuf:"bytes,8,opt,name=meeting_at,json=meetingAt,proto3" json:"meeting_at,omitempty"`
 	MaxApply          int64                `protobuf:"varint,9,opt,name=max_apply,json=maxApply,proto3" json:"max_apply,omitempty"`
 	ImageIdsToDelete  []int64              `protobuf:"varint,10,rep,packed,name=image_ids_to_delete,json=imageIdsToDelete,proto3" json:"image_ids_to_delete,omitempty"`
+	ApplyDeadline     *timestamp.Timestamp `protobuf:"bytes,11,opt,name=apply_deadline,json=applyDeadline,proto3" json:"apply_deadline,omitempty"` // meeting_at以前。空の場合はmeeting_at
 }
 
 func (x *UpdatePostReqInfo) Reset() {
@@ -882,6 +1068,13 @@ func (x *UpdatePostReqInfo) GetImageIdsToDelete() []int64 {
 	return nil
 }
 
+func (x *UpdatePostReqInfo) GetApplyDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.ApplyDeadline
+	}
+	return nil
+}
+
 type UpdatePostReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1028,7 +1221,8 @@ type DeletePostRes struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	SagaId  string `protobuf:"bytes,2,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
 }
 
 func (x *DeletePostRes) Reset() {
@@ -1070,6 +1264,131 @@ func (x *DeletePostRes) GetSuccess() bool {
 	return false
 }
 
+func (x *DeletePostRes) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+type PublishPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 投稿者本人のみ公開できる
+}
+
+func (x *PublishPostReq) Reset() {
+	*x = PublishPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostReq) ProtoMessage() {}
+
+func (x *PublishPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostReq.ProtoReflect.Descriptor instead.
+func (*PublishPostReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PublishPostReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PublishPostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CancelPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 投稿者本人のみキャンセルできる
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelPostReq) Reset() {
+	*x = CancelPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPostReq) ProtoMessage() {}
+
+func (x *CancelPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPostReq.ProtoReflect.Descriptor instead.
+func (*CancelPostReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelPostReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelPostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelPostReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type GetApplyPostReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1081,7 +1400,7 @@ type GetApplyPostReq struct {
 func (x *GetApplyPostReq) Reset() {
 	*x = GetApplyPostReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[12]
+		mi := &file_post_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1094,7 +1413,7 @@ func (x *GetApplyPostReq) String() string {
 func (*GetApplyPostReq) ProtoMessage() {}
 
 func (x *GetApplyPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[12]
+	mi := &file_post_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1426,7 @@ func (x *GetApplyPostReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplyPostReq.ProtoReflect.Descriptor instead.
 func (*GetApplyPostReq) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{12}
+	return file_post_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetApplyPostReq) GetId() int64 {
@@ -1128,7 +1447,7 @@ type ListApplyPostsReq struct {
 func (x *ListApplyPostsReq) Reset() {
 	*x = ListApplyPostsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[13]
+		mi := &file_post_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1141,7 +1460,7 @@ func (x *ListApplyPostsReq) String() string {
 func (*ListApplyPostsReq) ProtoMessage() {}
 
 func (x *ListApplyPostsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[13]
+	mi := &file_post_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,7 +1473,7 @@ func (x *ListApplyPostsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListApplyPostsReq.ProtoReflect.Descriptor instead.
 func (*ListApplyPostsReq) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{13}
+	return file_post_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListApplyPostsReq) GetFilter() *ListApplyPostsReq_Filter {
@@ -1175,7 +1494,7 @@ type ListApplyPostsRes struct {
 func (x *ListApplyPostsRes) Reset() {
 	*x = ListApplyPostsRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[14]
+		mi := &file_post_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1188,7 +1507,7 @@ func (x *ListApplyPostsRes) String() string {
 func (*ListApplyPostsRes) ProtoMessage() {}
 
 func (x *ListApplyPostsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[14]
+	mi := &file_post_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1201,7 +1520,7 @@ func (x *ListApplyPostsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListApplyPostsRes.ProtoReflect.Descriptor instead.
 func (*ListApplyPostsRes) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{14}
+	return file_post_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListApplyPostsRes) GetApplyPosts() []*ApplyPost {
@@ -1222,7 +1541,7 @@ type BatchGetApplyPostsByPostIDsReq struct {
 func (x *BatchGetApplyPostsByPostIDsReq) Reset() {
 	*x = BatchGetApplyPostsByPostIDsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[15]
+		mi := &file_post_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1235,7 +1554,7 @@ func (x *BatchGetApplyPostsByPostIDsReq) String() string {
 func (*BatchGetApplyPostsByPostIDsReq) ProtoMessage() {}
 
 func (x *BatchGetApplyPostsByPostIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[15]
+	mi := &file_post_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1567,7 @@ func (x *BatchGetApplyPostsByPostIDsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetApplyPostsByPostIDsReq.ProtoReflect.Descriptor instead.
 func (*BatchGetApplyPostsByPostIDsReq) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{15}
+	return file_post_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BatchGetApplyPostsByPostIDsReq) GetPostIds() []int64 {
@@ -1269,7 +1588,7 @@ type BatchGetApplyPostsByPostIDsRes struct {
 func (x *BatchGetApplyPostsByPostIDsRes) Reset() {
 	*x = BatchGetApplyPostsByPostIDsRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[16]
+		mi := &file_post_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1282,7 +1601,7 @@ func (x *BatchGetApplyPostsByPostIDsRes) String() string {
 func (*BatchGetApplyPostsByPostIDsRes) ProtoMessage() {}
 
 func (x *BatchGetApplyPostsByPostIDsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[16]
+	mi := &file_post_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1614,7 @@ func (x *BatchGetApplyPostsByPostIDsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGetApplyPostsByPostIDsRes.ProtoReflect.Descriptor instead.
 func (*BatchGetApplyPostsByPostIDsRes) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{16}
+	return file_post_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BatchGetApplyPostsByPostIDsRes) GetApplyPosts() []*ApplyPost {
@@ -1310,14 +1629,16 @@ type CreateApplyPostReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId    int64  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId    int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PartySize int64  `protobuf:"varint,3,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"` // 0の場合は1人
+	Note      string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
 }
 
 func (x *CreateApplyPostReq) Reset() {
 	*x = CreateApplyPostReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[17]
+		mi := &file_post_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1330,7 +1651,7 @@ func (x *CreateApplyPostReq) String() string {
 func (*CreateApplyPostReq) ProtoMessage() {}
 
 func (x *CreateApplyPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[17]
+	mi := &file_post_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +1664,7 @@ func (x *CreateApplyPostReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateApplyPostReq.ProtoReflect.Descriptor instead.
 func (*CreateApplyPostReq) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{17}
+	return file_post_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateApplyPostReq) GetPostId() int64 {
@@ -1360,31 +1681,714 @@ func (x *CreateApplyPostReq) GetUserId() int64 {
 	return 0
 }
 
-type DeleteApplyPostReq struct {
+func (x *CreateApplyPostReq) GetPartySize() int64 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *CreateApplyPostReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AcceptApplyPostReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 投稿者本人のみ
 }
 
-func (x *DeleteApplyPostReq) Reset() {
-	*x = DeleteApplyPostReq{}
+func (x *AcceptApplyPostReq) Reset() {
+	*x = AcceptApplyPostReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[18]
+		mi := &file_post_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteApplyPostReq) String() string {
+func (x *AcceptApplyPostReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteApplyPostReq) ProtoMessage() {}
+func (*AcceptApplyPostReq) ProtoMessage() {}
 
-func (x *DeleteApplyPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[18]
+func (x *AcceptApplyPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptApplyPostReq.ProtoReflect.Descriptor instead.
+func (*AcceptApplyPostReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AcceptApplyPostReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AcceptApplyPostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeclineApplyPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 投稿者本人のみ
+}
+
+func (x *DeclineApplyPostReq) Reset() {
+	*x = DeclineApplyPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineApplyPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineApplyPostReq) ProtoMessage() {}
+
+func (x *DeclineApplyPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineApplyPostReq.ProtoReflect.Descriptor instead.
+func (*DeclineApplyPostReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeclineApplyPostReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeclineApplyPostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteApplyPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteApplyPostReq) Reset() {
+	*x = DeleteApplyPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteApplyPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplyPostReq) ProtoMessage() {}
+
+func (x *DeleteApplyPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplyPostReq.ProtoReflect.Descriptor instead.
+func (*DeleteApplyPostReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteApplyPostReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SagaStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId       string               `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	SagaType     string               `protobuf:"bytes,2,opt,name=saga_type,json=sagaType,proto3" json:"saga_type,omitempty"`
+	CurrentState string               `protobuf:"bytes,3,opt,name=current_state,json=currentState,proto3" json:"current_state,omitempty"`
+	RejectReason string               `protobuf:"bytes,4,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"` // 補償された場合のみ
+	Finished     bool                 `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`                            // これ以上ステートが変わらない場合true
+	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RetryCount   int64                `protobuf:"varint,8,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"` // コマンドを再発行した回数
+}
+
+func (x *SagaStatus) Reset() {
+	*x = SagaStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SagaStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SagaStatus) ProtoMessage() {}
+
+func (x *SagaStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SagaStatus.ProtoReflect.Descriptor instead.
+func (*SagaStatus) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SagaStatus) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *SagaStatus) GetSagaType() string {
+	if x != nil {
+		return x.SagaType
+	}
+	return ""
+}
+
+func (x *SagaStatus) GetCurrentState() string {
+	if x != nil {
+		return x.CurrentState
+	}
+	return ""
+}
+
+func (x *SagaStatus) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *SagaStatus) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *SagaStatus) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SagaStatus) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SagaStatus) GetRetryCount() int64 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+type GetSagaStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId string `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+}
+
+func (x *GetSagaStatusReq) Reset() {
+	*x = GetSagaStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSagaStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSagaStatusReq) ProtoMessage() {}
+
+func (x *GetSagaStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSagaStatusReq.ProtoReflect.Descriptor instead.
+func (*GetSagaStatusReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetSagaStatusReq) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+type WatchSagaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId string `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+}
+
+func (x *WatchSagaReq) Reset() {
+	*x = WatchSagaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSagaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSagaReq) ProtoMessage() {}
+
+func (x *WatchSagaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSagaReq.ProtoReflect.Descriptor instead.
+func (*WatchSagaReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchSagaReq) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+type SagaTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SagaId       string               `protobuf:"bytes,2,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	Event        string               `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	FromState    string               `protobuf:"bytes,4,opt,name=from_state,json=fromState,proto3" json:"from_state,omitempty"`
+	ToState      string               `protobuf:"bytes,5,opt,name=to_state,json=toState,proto3" json:"to_state,omitempty"`
+	Payload      string               `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"` // 遷移で発行したイベントのJSON
+	ErrorMessage string               `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SagaTransition) Reset() {
+	*x = SagaTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SagaTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SagaTransition) ProtoMessage() {}
+
+func (x *SagaTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SagaTransition.ProtoReflect.Descriptor instead.
+func (*SagaTransition) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SagaTransition) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SagaTransition) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *SagaTransition) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *SagaTransition) GetFromState() string {
+	if x != nil {
+		return x.FromState
+	}
+	return ""
+}
+
+func (x *SagaTransition) GetToState() string {
+	if x != nil {
+		return x.ToState
+	}
+	return ""
+}
+
+func (x *SagaTransition) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *SagaTransition) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SagaTransition) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSagaTransitionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId string `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+}
+
+func (x *ListSagaTransitionsReq) Reset() {
+	*x = ListSagaTransitionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSagaTransitionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSagaTransitionsReq) ProtoMessage() {}
+
+func (x *ListSagaTransitionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSagaTransitionsReq.ProtoReflect.Descriptor instead.
+func (*ListSagaTransitionsReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSagaTransitionsReq) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+type ListSagaTransitionsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transitions []*SagaTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (x *ListSagaTransitionsRes) Reset() {
+	*x = ListSagaTransitionsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSagaTransitionsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSagaTransitionsRes) ProtoMessage() {}
+
+func (x *ListSagaTransitionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSagaTransitionsRes.ProtoReflect.Descriptor instead.
+func (*ListSagaTransitionsRes) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSagaTransitionsRes) GetTransitions() []*SagaTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type ListSagasReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaType      string               `protobuf:"bytes,1,opt,name=saga_type,json=sagaType,proto3" json:"saga_type,omitempty"`
+	States        []string             `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+	UpdatedBefore *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"` // これより前から更新されていないサガに絞り込む
+	PageSize      int64                `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`               // 100件以下。ゼロ値の場合、デフォルト設定で10件
+}
+
+func (x *ListSagasReq) Reset() {
+	*x = ListSagasReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSagasReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSagasReq) ProtoMessage() {}
+
+func (x *ListSagasReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSagasReq.ProtoReflect.Descriptor instead.
+func (*ListSagasReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListSagasReq) GetSagaType() string {
+	if x != nil {
+		return x.SagaType
+	}
+	return ""
+}
+
+func (x *ListSagasReq) GetStates() []string {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListSagasReq) GetUpdatedBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *ListSagasReq) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListSagasRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sagas []*SagaStatus `protobuf:"bytes,1,rep,name=sagas,proto3" json:"sagas,omitempty"`
+}
+
+func (x *ListSagasRes) Reset() {
+	*x = ListSagasRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSagasRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSagasRes) ProtoMessage() {}
+
+func (x *ListSagasRes) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSagasRes.ProtoReflect.Descriptor instead.
+func (*ListSagasRes) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListSagasRes) GetSagas() []*SagaStatus {
+	if x != nil {
+		return x.Sagas
+	}
+	return nil
+}
+
+type RetrySagaStepReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId string `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+}
+
+func (x *RetrySagaStepReq) Reset() {
+	*x = RetrySagaStepReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrySagaStepReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrySagaStepReq) ProtoMessage() {}
+
+func (x *RetrySagaStepReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,16 +2399,71 @@ func (x *DeleteApplyPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteApplyPostReq.ProtoReflect.Descriptor instead.
-func (*DeleteApplyPostReq) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RetrySagaStepReq.ProtoReflect.Descriptor instead.
+func (*RetrySagaStepReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeleteApplyPostReq) GetId() int64 {
+func (x *RetrySagaStepReq) GetSagaId() string {
 	if x != nil {
-		return x.Id
+		return x.SagaId
 	}
-	return 0
+	return ""
+}
+
+type ForceCompensateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SagaId string `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ForceCompensateReq) Reset() {
+	*x = ForceCompensateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_post_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceCompensateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceCompensateReq) ProtoMessage() {}
+
+func (x *ForceCompensateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceCompensateReq.ProtoReflect.Descriptor instead.
+func (*ForceCompensateReq) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ForceCompensateReq) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *ForceCompensateReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
 type ListPostsReq_Filter struct {
@@ -1420,13 +2479,15 @@ type ListPostsReq_Filter struct {
 	CanApply          bool                        `protobuf:"varint,6,opt,name=can_apply,json=canApply,proto3" json:"can_apply,omitempty"` // trueにすると、応募可能な投稿のみを絞り込める。
 	OrderBy           ListPostsReq_Filter_OrderBy `protobuf:"varint,7,opt,name=order_by,json=orderBy,proto3,enum=post.ListPostsReq_Filter_OrderBy" json:"order_by,omitempty"`
 	SortBy            ListPostsReq_Filter_SortBy  `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=post.ListPostsReq_Filter_SortBy" json:"sort_by,omitempty"`
-	UserId            int64                       `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ここに値が入っているとユーザーの投稿を絞り込める
+	UserId            int64                       `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                 // ここに値が入っているとユーザーの投稿を絞り込める
+	RequesterId       int64                       `protobuf:"varint,10,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` // user_idと同じ場合は公開されていない投稿も返す
+	Draft             bool                        `protobuf:"varint,11,opt,name=draft,proto3" json:"draft,omitempty"`                                // trueの場合は下書きだけを返す。user_idとrequester_idが同じ場合のみ
 }
 
 func (x *ListPostsReq_Filter) Reset() {
 	*x = ListPostsReq_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[19]
+		mi := &file_post_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1439,7 +2500,7 @@ func (x *ListPostsReq_Filter) String() string {
 func (*ListPostsReq_Filter) ProtoMessage() {}
 
 func (x *ListPostsReq_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[19]
+	mi := &file_post_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,6 +2579,20 @@ func (x *ListPostsReq_Filter) GetUserId() int64 {
 	return 0
 }
 
+func (x *ListPostsReq_Filter) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *ListPostsReq_Filter) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
 type ListApplyPostsReq_Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1530,7 +2605,7 @@ type ListApplyPostsReq_Filter struct {
 func (x *ListApplyPostsReq_Filter) Reset() {
 	*x = ListApplyPostsReq_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_post_proto_msgTypes[20]
+		mi := &file_post_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1543,7 +2618,7 @@ func (x *ListApplyPostsReq_Filter) String() string {
 func (*ListApplyPostsReq_Filter) ProtoMessage() {}
 
 func (x *ListApplyPostsReq_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[20]
+	mi := &file_post_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1556,7 +2631,7 @@ func (x *ListApplyPostsReq_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListApplyPostsReq_Filter.ProtoReflect.Descriptor instead.
 func (*ListApplyPostsReq_Filter) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{13, 0}
+	return file_post_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *ListApplyPostsReq_Filter) GetUserId() int64 {
@@ -1582,7 +2657,7 @@ var file_post_proto_rawDesc = []byte{
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x03, 0x0a, 0x04, 0x50, 0x6f,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x05, 0x0a, 0x04, 0x50, 0x6f,
 	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
@@ -1611,239 +2686,424 @@ var file_post_proto_rawDesc = []byte{
 	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc3, 0x01,
-	0x0a, 0x09, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
-	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd4, 0x05, 0x0a, 0x0c, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x3b, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
-	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x22, 0x02, 0x18, 0x1e, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0xc1, 0x04,
-	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66,
-	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x18, 0x2f, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x14, 0x66, 0x69, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x18, 0x04, 0x52, 0x11, 0x66,
-	0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64,
-	0x12, 0x40, 0x0a, 0x0d, 0x66, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x42, 0x1c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22,
-	0x04, 0x22, 0x02, 0x18, 0x5f, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0xfa, 0x42, 0x05,
-	0x92, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x66, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x49,
-	0x64, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
-	0x41, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3e, 0x0a, 0x0d, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x61, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6d, 0x65, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x41, 0x74, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x5f, 0x61, 0x70,
-	0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02,
-	0x10, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x43, 0x0a, 0x07, 0x73,
-	0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x07, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x42, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x12, 0x07,
-	0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x01, 0x22, 0x28, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42,
-	0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10,
-	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x45, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x54, 0x10,
-	0x01, 0x22, 0x58, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x12, 0x20, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f,
-	0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x11,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x6a, 0x02, 0x08, 0x01,
-	0x48, 0x00, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x7a, 0x04, 0x18,
-	0x80, 0x80, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75, 0x6e,
-	0x6b, 0x42, 0x0b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xc1,
-	0x03, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x14, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
-	0xd0, 0x0f, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x14, 0x66,
-	0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x22, 0x04,
-	0x18, 0x04, 0x28, 0x01, 0x52, 0x11, 0x66, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0d, 0x66, 0x69, 0x73, 0x68, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x42, 0x12,
-	0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x18, 0x01, 0x22, 0x06, 0x22, 0x04, 0x18, 0x5f,
-	0x28, 0x01, 0x52, 0x0b, 0x66, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12,
-	0x2e, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x22, 0x04, 0x18, 0x2f, 0x28,
-	0x01, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12,
-	0x34, 0x0a, 0x10, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05,
-	0x10, 0x1b, 0x18, 0xff, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x41, 0x0a,
+	0x0e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x22, 0x58, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x55, 0x42, 0x4c, 0x49,
+	0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x09, 0x0a, 0x05, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x05, 0x22, 0xf8, 0x02, 0x0a, 0x09, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52,
-	0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x6d, 0x61,
-	0x78, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x48, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70,
-	0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x22, 0xf9, 0x03, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72,
-	0x04, 0x10, 0x01, 0x18, 0x14, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
-	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xd0, 0x0f, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x14, 0x66, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70,
-	0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x09, 0xfa, 0x42, 0x06, 0x22, 0x04, 0x18, 0x04, 0x28, 0x01, 0x52, 0x11, 0x66, 0x69, 0x73,
-	0x68, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x36,
-	0x0a, 0x0d, 0x66, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x03, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x18,
-	0x01, 0x22, 0x06, 0x22, 0x04, 0x18, 0x5f, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x69, 0x73, 0x68, 0x54,
-	0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xfa,
-	0x42, 0x06, 0x22, 0x04, 0x18, 0x2f, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x10, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x1b, 0x18, 0xff, 0x01, 0x52, 0x0e, 0x6d, 0x65,
-	0x65, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0a,
-	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43,
+	0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x4c,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52,
+	0x41, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53,
+	0x54, 0x45, 0x44, 0x10, 0x04, 0x22, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x8d, 0x06, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x18, 0x1e, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x1a, 0xfa, 0x04, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a,
+	0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x18, 0x2f, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x14, 0x66,
+	0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x18, 0x04, 0x52, 0x11, 0x66, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x42, 0x1c, 0xfa, 0x42,
+	0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x22, 0x02, 0x18, 0x5f, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
+	0x08, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x66, 0x69, 0x73, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x41,
-	0x74, 0x12, 0x24, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x08, 0x6d,
-	0x61, 0x78, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x13, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x5f, 0x69, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0a,
-	0x20, 0x03, 0x28, 0x03, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01, 0x18, 0x01,
-	0x22, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x10, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73,
-	0x54, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6e, 0x66,
-	0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x11, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x6a, 0x02, 0x08, 0x01, 0x48, 0x00, 0x52,
-	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x12, 0x21, 0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68,
-	0x75, 0x6e, 0x6b, 0x42, 0x0b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x03, 0xf8, 0x42, 0x01,
-	0x22, 0x28, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3e, 0x0a, 0x0d, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x61, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x63, 0x61, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x12, 0x43, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72,
+	0x74, 0x42, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x1c, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x41, 0x53, 0x43, 0x10, 0x01, 0x22, 0x28, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4d, 0x45, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x54, 0x10, 0x01, 0x22,
+	0x58, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x11, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x6a, 0x02, 0x08, 0x01, 0x48, 0x00,
+	0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x12, 0x2c, 0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x7a, 0x04, 0x18, 0x80, 0x80,
+	0x04, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42,
+	0x0b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xa4, 0x04, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x14, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xd0, 0x0f,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x14, 0x66, 0x69, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x22, 0x04, 0x18, 0x04,
+	0x28, 0x01, 0x52, 0x11, 0x66, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0d, 0x66, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x42, 0x12, 0xfa, 0x42,
+	0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x18, 0x01, 0x22, 0x06, 0x22, 0x04, 0x18, 0x5f, 0x28, 0x01,
+	0x52, 0x0b, 0x66, 0x69, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a,
+	0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x22, 0x04, 0x18, 0x2f, 0x28, 0x01, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x10, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x1b,
+	0x18, 0xff, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x20,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x4b, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x0d,
+	0x61, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x22, 0x48, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x22, 0xc6, 0x04,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06,
+	0x72, 0x04, 0x10, 0x01, 0x18, 0x14, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xd0, 0x0f, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x14, 0x66, 0x69, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x70, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x22, 0x04, 0x18, 0x04, 0x28, 0x01, 0x52, 0x11, 0x66, 0x69,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x0d, 0x66, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01,
+	0x18, 0x01, 0x22, 0x06, 0x22, 0x04, 0x18, 0x5f, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x69, 0x73, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x22, 0x04, 0x18, 0x2f, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x10, 0x6d, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x1b, 0x18, 0xff, 0x01, 0x52, 0x0e, 0x6d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a,
+	0x0a, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x41, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x13, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x03, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01, 0x18,
+	0x01, 0x22, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x10, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x73, 0x54, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x61, 0x70, 0x70,
+	0x6c, 0x79, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x6a, 0x02, 0x08, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x21,
+	0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x42, 0x0b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x28,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x0e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28,
+	0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x0d, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xe8,
+	0x07, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x1a, 0x3a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0x45, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x6c,
+	0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92,
+	0x01, 0x0a, 0x08, 0x01, 0x18, 0x01, 0x22, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x20, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28,
+	0x00, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0xf4, 0x03, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x12, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x28, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x13, 0x44,
+	0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2d, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbf, 0x02, 0x0a,
+	0x0a, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61,
+	0x67, 0x61, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x61, 0x67, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x34,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x61,
+	0x67, 0x61, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x61, 0x67,
+	0x61, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06,
+	0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x0e, 0x53, 0x61, 0x67, 0x61, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x67,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3a, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x06, 0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x61, 0x67, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53,
+	0x61, 0x67, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x61, 0x67, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x61, 0x67, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x41, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x18, 0x64, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x61, 0x67, 0x61, 0x73, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x61, 0x67,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x73, 0x61, 0x67, 0x61,
+	0x73, 0x22, 0x34, 0x0a, 0x10, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a,
+	0x07, 0x73, 0x61, 0x67, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x61, 0x67, 0x61, 0x49, 0x64, 0x12,
+	0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x32, 0xcf, 0x07, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x10,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x12, 0x38, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x28, 0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0a,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x28, 0x01, 0x12, 0x36, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c,
 	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
-	0x3a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x12, 0x30, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x22, 0x4d, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x1b, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42,
+	0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x70,
 	0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01,
-	0x18, 0x01, 0x22, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
-	0x73, 0x22, 0x52, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73,
-	0x52, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x79,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x58, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x07, 0x70,
-	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x2d, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x32, 0xfb,
-	0x04, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x27,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0a, 0x2e, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x28, 0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0a, 0x2e, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x28, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x69,
-	0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73, 0x12, 0x24, 0x2e,
-	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73,
-	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x50,
-	0x6f, 0x73, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x06, 0x5a, 0x04,
+	0x73, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x43, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x61, 0x67, 0x61,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x33, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x61, 0x67, 0x61, 0x12, 0x12, 0x2e,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x61, 0x67, 0x61, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x30, 0x01, 0x32, 0x94, 0x02, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67,
+	0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x33, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x73, 0x52,
+	0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53, 0x61, 0x67, 0x61, 0x53,
+	0x74, 0x65, 0x70, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a,
+	0x0f, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x53, 0x61, 0x67, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x06, 0x5a, 0x04,
 	0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -1859,80 +3119,127 @@ func file_post_proto_rawDescGZIP() []byte {
 	return file_post_proto_rawDescData
 }
 
-var file_post_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_post_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_post_proto_goTypes = []interface{}{
-	(ListPostsReq_Filter_OrderBy)(0),       // 0: post.ListPostsReq.Filter.OrderBy
-	(ListPostsReq_Filter_SortBy)(0),        // 1: post.ListPostsReq.Filter.SortBy
-	(*Post)(nil),                           // 2: post.Post
-	(*ApplyPost)(nil),                      // 3: post.ApplyPost
-	(*GetPostReq)(nil),                     // 4: post.GetPostReq
-	(*ListPostsReq)(nil),                   // 5: post.ListPostsReq
-	(*ListPostsRes)(nil),                   // 6: post.ListPostsRes
-	(*CreatePostReq)(nil),                  // 7: post.CreatePostReq
-	(*CreatePostReqInfo)(nil),              // 8: post.CreatePostReqInfo
-	(*CreatePostRes)(nil),                  // 9: post.CreatePostRes
-	(*UpdatePostReqInfo)(nil),              // 10: post.UpdatePostReqInfo
-	(*UpdatePostReq)(nil),                  // 11: post.UpdatePostReq
-	(*DeletePostReq)(nil),                  // 12: post.DeletePostReq
-	(*DeletePostRes)(nil),                  // 13: post.DeletePostRes
-	(*GetApplyPostReq)(nil),                // 14: post.GetApplyPostReq
-	(*ListApplyPostsReq)(nil),              // 15: post.ListApplyPostsReq
-	(*ListApplyPostsRes)(nil),              // 16: post.ListApplyPostsRes
-	(*BatchGetApplyPostsByPostIDsReq)(nil), // 17: post.BatchGetApplyPostsByPostIDsReq
-	(*BatchGetApplyPostsByPostIDsRes)(nil), // 18: post.BatchGetApplyPostsByPostIDsRes
-	(*CreateApplyPostReq)(nil),             // 19: post.CreateApplyPostReq
-	(*DeleteApplyPostReq)(nil),             // 20: post.DeleteApplyPostReq
-	(*ListPostsReq_Filter)(nil),            // 21: post.ListPostsReq.Filter
-	(*ListApplyPostsReq_Filter)(nil),       // 22: post.ListApplyPostsReq.Filter
-	(*timestamp.Timestamp)(nil),            // 23: google.protobuf.Timestamp
-	(*empty.Empty)(nil),                    // 24: google.protobuf.Empty
+	(Post_Status)(0),                       // 0: post.Post.Status
+	(ApplyPost_Status)(0),                  // 1: post.ApplyPost.Status
+	(ListPostsReq_Filter_OrderBy)(0),       // 2: post.ListPostsReq.Filter.OrderBy
+	(ListPostsReq_Filter_SortBy)(0),        // 3: post.ListPostsReq.Filter.SortBy
+	(*Post)(nil),                           // 4: post.Post
+	(*ApplyPost)(nil),                      // 5: post.ApplyPost
+	(*GetPostReq)(nil),                     // 6: post.GetPostReq
+	(*ListPostsReq)(nil),                   // 7: post.ListPostsReq
+	(*ListPostsRes)(nil),                   // 8: post.ListPostsRes
+	(*CreatePostReq)(nil),                  // 9: post.CreatePostReq
+	(*CreatePostReqInfo)(nil),              // 10: post.CreatePostReqInfo
+	(*CreatePostRes)(nil),                  // 11: post.CreatePostRes
+	(*UpdatePostReqInfo)(nil),              // 12: post.UpdatePostReqInfo
+	(*UpdatePostReq)(nil),                  // 13: post.UpdatePostReq
+	(*DeletePostReq)(nil),                  // 14: post.DeletePostReq
+	(*DeletePostRes)(nil),                  // 15: post.DeletePostRes
+	(*PublishPostReq)(nil),                 // 16: post.PublishPostReq
+	(*CancelPostReq)(nil),                  // 17: post.CancelPostReq
+	(*GetApplyPostReq)(nil),                // 18: post.GetApplyPostReq
+	(*ListApplyPostsReq)(nil),              // 19: post.ListApplyPostsReq
+	(*ListApplyPostsRes)(nil),              // 20: post.ListApplyPostsRes
+	(*BatchGetApplyPostsByPostIDsReq)(nil), // 21: post.BatchGetApplyPostsByPostIDsReq
+	(*BatchGetApplyPostsByPostIDsRes)(nil), // 22: post.BatchGetApplyPostsByPostIDsRes
+	(*CreateApplyPostReq)(nil),             // 23: post.CreateApplyPostReq
+	(*AcceptApplyPostReq)(nil),             // 24: post.AcceptApplyPostReq
+	(*DeclineApplyPostReq)(nil),            // 25: post.DeclineApplyPostReq
+	(*DeleteApplyPostReq)(nil),             // 26: post.DeleteApplyPostReq
+	(*SagaStatus)(nil),                     // 27: post.SagaStatus
+	(*GetSagaStatusReq)(nil),               // 28: post.GetSagaStatusReq
+	(*WatchSagaReq)(nil),                   // 29: post.WatchSagaReq
+	(*SagaTransition)(nil),                 // 30: post.SagaTransition
+	(*ListSagaTransitionsReq)(nil),         // 31: post.ListSagaTransitionsReq
+	(*ListSagaTransitionsRes)(nil),         // 32: post.ListSagaTransitionsRes
+	(*ListSagasReq)(nil),                   // 33: post.ListSagasReq
+	(*ListSagasRes)(nil),                   // 34: post.ListSagasRes
+	(*RetrySagaStepReq)(nil),               // 35: post.RetrySagaStepReq
+	(*ForceCompensateReq)(nil),             // 36: post.ForceCompensateReq
+	(*ListPostsReq_Filter)(nil),            // 37: post.ListPostsReq.Filter
+	(*ListApplyPostsReq_Filter)(nil),       // 38: post.ListApplyPostsReq.Filter
+	(*timestamp.Timestamp)(nil),            // 39: google.protobuf.Timestamp
+	(*empty.Empty)(nil),                    // 40: google.protobuf.Empty
 }
 var file_post_proto_depIdxs = []int32{
-	23, // 0: post.Post.meeting_at:type_name -> google.protobuf.Timestamp
-	23, // 1: post.Post.created_at:type_name -> google.protobuf.Timestamp
-	23, // 2: post.Post.updated_at:type_name -> google.protobuf.Timestamp
-	23, // 3: post.ApplyPost.created_at:type_name -> google.protobuf.Timestamp
-	23, // 4: post.ApplyPost.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 5: post.ListPostsReq.filter:type_name -> post.ListPostsReq.Filter
-	2,  // 6: post.ListPostsRes.posts:type_name -> post.Post
-	8,  // 7: post.CreatePostReq.info:type_name -> post.CreatePostReqInfo
-	23, // 8: post.CreatePostReqInfo.meeting_at:type_name -> google.protobuf.Timestamp
-	2,  // 9: post.CreatePostRes.post:type_name -> post.Post
-	23, // 10: post.UpdatePostReqInfo.meeting_at:type_name -> google.protobuf.Timestamp
-	10, // 11: post.UpdatePostReq.info:type_name -> post.UpdatePostReqInfo
-	22, // 12: post.ListApplyPostsReq.filter:type_name -> post.ListApplyPostsReq.Filter
-	3,  // 13: post.ListApplyPostsRes.apply_posts:type_name -> post.ApplyPost
-	3,  // 14: post.BatchGetApplyPostsByPostIDsRes.apply_posts:type_name -> post.ApplyPost
-	23, // 15: post.ListPostsReq.Filter.meeting_at_from:type_name -> google.protobuf.Timestamp
-	23, // 16: post.ListPostsReq.Filter.meeting_at_to:type_name -> google.protobuf.Timestamp
-	0,  // 17: post.ListPostsReq.Filter.order_by:type_name -> post.ListPostsReq.Filter.OrderBy
-	1,  // 18: post.ListPostsReq.Filter.sort_by:type_name -> post.ListPostsReq.Filter.SortBy
-	4,  // 19: post.PostService.GetPost:input_type -> post.GetPostReq
-	5,  // 20: post.PostService.ListPosts:input_type -> post.ListPostsReq
-	7,  // 21: post.PostService.CreatePost:input_type -> post.CreatePostReq
-	11, // 22: post.PostService.UpdatePost:input_type -> post.UpdatePostReq
-	12, // 23: post.PostService.DeletePost:input_type -> post.DeletePostReq
-	14, // 24: post.PostService.GetApplyPost:input_type -> post.GetApplyPostReq
-	15, // 25: post.PostService.ListApplyPosts:input_type -> post.ListApplyPostsReq
-	17, // 26: post.PostService.BatchGetApplyPostsByPostIDs:input_type -> post.BatchGetApplyPostsByPostIDsReq
-	19, // 27: post.PostService.CreateApplyPost:input_type -> post.CreateApplyPostReq
-	20, // 28: post.PostService.DeleteApplyPost:input_type -> post.DeleteApplyPostReq
-	2,  // 29: post.PostService.GetPost:output_type -> post.Post
-	6,  // 30: post.PostService.ListPosts:output_type -> post.ListPostsRes
-	9,  // 31: post.PostService.CreatePost:output_type -> post.CreatePostRes
-	2,  // 32: post.PostService.UpdatePost:output_type -> post.Post
-	24, // 33: post.PostService.DeletePost:output_type -> google.protobuf.Empty
-	3,  // 34: post.PostService.GetApplyPost:output_type -> post.ApplyPost
-	16, // 35: post.PostService.ListApplyPosts:output_type -> post.ListApplyPostsRes
-	18, // 36: post.PostService.BatchGetApplyPostsByPostIDs:output_type -> post.BatchGetApplyPostsByPostIDsRes
-	3,  // 37: post.PostService.CreateApplyPost:output_type -> post.ApplyPost
-	24, // 38: post.PostService.DeleteApplyPost:output_type -> google.protobuf.Empty
-	29, // [29:39] is the sub-list for method output_type
-	19, // [19:29] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	39, // 0: post.Post.meeting_at:type_name -> google.protobuf.Timestamp
+	39, // 1: post.Post.created_at:type_name -> google.protobuf.Timestamp
+	39, // 2: post.Post.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: post.Post.status:type_name -> post.Post.Status
+	39, // 4: post.Post.apply_deadline:type_name -> google.protobuf.Timestamp
+	39, // 5: post.ApplyPost.created_at:type_name -> google.protobuf.Timestamp
+	39, // 6: post.ApplyPost.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: post.ApplyPost.status:type_name -> post.ApplyPost.Status
+	37, // 8: post.ListPostsReq.filter:type_name -> post.ListPostsReq.Filter
+	4,  // 9: post.ListPostsRes.posts:type_name -> post.Post
+	10, // 10: post.CreatePostReq.info:type_name -> post.CreatePostReqInfo
+	39, // 11: post.CreatePostReqInfo.meeting_at:type_name -> google.protobuf.Timestamp
+	39, // 12: post.CreatePostReqInfo.apply_deadline:type_name -> google.protobuf.Timestamp
+	4,  // 13: post.CreatePostRes.post:type_name -> post.Post
+	39, // 14: post.UpdatePostReqInfo.meeting_at:type_name -> google.protobuf.Timestamp
+	39, // 15: post.UpdatePostReqInfo.apply_deadline:type_name -> google.protobuf.Timestamp
+	12, // 16: post.UpdatePostReq.info:type_name -> post.UpdatePostReqInfo
+	38, // 17: post.ListApplyPostsReq.filter:type_name -> post.ListApplyPostsReq.Filter
+	5,  // 18: post.ListApplyPostsRes.apply_posts:type_name -> post.ApplyPost
+	5,  // 19: post.BatchGetApplyPostsByPostIDsRes.apply_posts:type_name -> post.ApplyPost
+	39, // 20: post.SagaStatus.created_at:type_name -> google.protobuf.Timestamp
+	39, // 21: post.SagaStatus.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 22: post.SagaTransition.created_at:type_name -> google.protobuf.Timestamp
+	30, // 23: post.ListSagaTransitionsRes.transitions:type_name -> post.SagaTransition
+	39, // 24: post.ListSagasReq.updated_before:type_name -> google.protobuf.Timestamp
+	27, // 25: post.ListSagasRes.sagas:type_name -> post.SagaStatus
+	39, // 26: post.ListPostsReq.Filter.meeting_at_from:type_name -> google.protobuf.Timestamp
+	39, // 27: post.ListPostsReq.Filter.meeting_at_to:type_name -> google.protobuf.Timestamp
+	2,  // 28: post.ListPostsReq.Filter.order_by:type_name -> post.ListPostsReq.Filter.OrderBy
+	3,  // 29: post.ListPostsReq.Filter.sort_by:type_name -> post.ListPostsReq.Filter.SortBy
+	6,  // 30: post.PostService.GetPost:input_type -> post.GetPostReq
+	7,  // 31: post.PostService.ListPosts:input_type -> post.ListPostsReq
+	9,  // 32: post.PostService.CreatePost:input_type -> post.CreatePostReq
+	13, // 33: post.PostService.UpdatePost:input_type -> post.UpdatePostReq
+	14, // 34: post.PostService.DeletePost:input_type -> post.DeletePostReq
+	16, // 35: post.PostService.PublishPost:input_type -> post.PublishPostReq
+	17, // 36: post.PostService.CancelPost:input_type -> post.CancelPostReq
+	18, // 37: post.PostService.GetApplyPost:input_type -> post.GetApplyPostReq
+	19, // 38: post.PostService.ListApplyPosts:input_type -> post.ListApplyPostsReq
+	21, // 39: post.PostService.BatchGetApplyPostsByPostIDs:input_type -> post.BatchGetApplyPostsByPostIDsReq
+	23, // 40: post.PostService.CreateApplyPost:input_type -> post.CreateApplyPostReq
+	24, // 41: post.PostService.AcceptApplyPost:input_type -> post.AcceptApplyPostReq
+	25, // 42: post.PostService.DeclineApplyPost:input_type -> post.DeclineApplyPostReq
+	26, // 43: post.PostService.DeleteApplyPost:input_type -> post.DeleteApplyPostReq
+	28, // 44: post.PostService.GetSagaStatus:input_type -> post.GetSagaStatusReq
+	29, // 45: post.PostService.WatchSaga:input_type -> post.WatchSagaReq
+	31, // 46: post.PostAdminService.ListSagaTransitions:input_type -> post.ListSagaTransitionsReq
+	33, // 47: post.PostAdminService.ListSagas:input_type -> post.ListSagasReq
+	35, // 48: post.PostAdminService.RetrySagaStep:input_type -> post.RetrySagaStepReq
+	36, // 49: post.PostAdminService.ForceCompensate:input_type -> post.ForceCompensateReq
+	4,  // 50: post.PostService.GetPost:output_type -> post.Post
+	8,  // 51: post.PostService.ListPosts:output_type -> post.ListPostsRes
+	11, // 52: post.PostService.CreatePost:output_type -> post.CreatePostRes
+	4,  // 53: post.PostService.UpdatePost:output_type -> post.Post
+	15, // 54: post.PostService.DeletePost:output_type -> post.DeletePostRes
+	11, // 55: post.PostService.PublishPost:output_type -> post.CreatePostRes
+	4,  // 56: post.PostService.CancelPost:output_type -> post.Post
+	5,  // 57: post.PostService.GetApplyPost:output_type -> post.ApplyPost
+	20, // 58: post.PostService.ListApplyPosts:output_type -> post.ListApplyPostsRes
+	22, // 59: post.PostService.BatchGetApplyPostsByPostIDs:output_type -> post.BatchGetApplyPostsByPostIDsRes
+	5,  // 60: post.PostService.CreateApplyPost:output_type -> post.ApplyPost
+	5,  // 61: post.PostService.AcceptApplyPost:output_type -> post.ApplyPost
+	5,  // 62: post.PostService.DeclineApplyPost:output_type -> post.ApplyPost
+	40, // 63: post.PostService.DeleteApplyPost:output_type -> google.protobuf.Empty
+	27, // 64: post.PostService.GetSagaStatus:output_type -> post.SagaStatus
+	27, // 65: post.PostService.WatchSaga:output_type -> post.SagaStatus
+	32, // 66: post.PostAdminService.ListSagaTransitions:output_type -> post.ListSagaTransitionsRes
+	34, // 67: post.PostAdminService.ListSagas:output_type -> post.ListSagasRes
+	27, // 68: post.PostAdminService.RetrySagaStep:output_type -> post.SagaStatus
+	27, // 69: post.PostAdminService.ForceCompensate:output_type -> post.SagaStatus
+	50, // [50:70] is the sub-list for method output_type
+	30, // [30:50] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_post_proto_init() }
@@ -2086,7 +3393,7 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplyPostReq); i {
+			switch v := v.(*PublishPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2098,7 +3405,7 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListApplyPostsReq); i {
+			switch v := v.(*CancelPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2110,7 +3417,7 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListApplyPostsRes); i {
+			switch v := v.(*GetApplyPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2122,7 +3429,7 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchGetApplyPostsByPostIDsReq); i {
+			switch v := v.(*ListApplyPostsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2134,7 +3441,7 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchGetApplyPostsByPostIDsRes); i {
+			switch v := v.(*ListApplyPostsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2146,7 +3453,7 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateApplyPostReq); i {
+			switch v := v.(*BatchGetApplyPostsByPostIDsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2158,7 +3465,7 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteApplyPostReq); i {
+			switch v := v.(*BatchGetApplyPostsByPostIDsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2170,7 +3477,7 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPostsReq_Filter); i {
+			switch v := v.(*CreateApplyPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2182,6 +3489,174 @@ func file_post_proto_init() {
 			}
 		}
 		file_post_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptApplyPostReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineApplyPostReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteApplyPostReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SagaStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSagaStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSagaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SagaTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSagaTransitionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSagaTransitionsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSagasReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSagasRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrySagaStepReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceCompensateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostsReq_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_post_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListApplyPostsReq_Filter); i {
 			case 0:
 				return &v.state
@@ -2209,10 +3684,10 @@ func file_post_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_post_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   21,
+			NumEnums:      4,
+			NumMessages:   35,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_post_proto_goTypes,
 		DependencyIndexes: file_post_proto_depIdxs,
@@ -2241,12 +3716,18 @@ type PostServiceClient interface {
 	ListPosts(ctx context.Context, in *ListPostsReq, opts ...grpc.CallOption) (*ListPostsRes, error)
 	CreatePost(ctx context.Context, opts ...grpc.CallOption) (PostService_CreatePostClient, error)
 	UpdatePost(ctx context.Context, opts ...grpc.CallOption) (PostService_UpdatePostClient, error)
-	DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostRes, error)
+	PublishPost(ctx context.Context, in *PublishPostReq, opts ...grpc.CallOption) (*CreatePostRes, error)
+	CancelPost(ctx context.Context, in *CancelPostReq, opts ...grpc.CallOption) (*Post, error)
 	GetApplyPost(ctx context.Context, in *GetApplyPostReq, opts ...grpc.CallOption) (*ApplyPost, error)
 	ListApplyPosts(ctx context.Context, in *ListApplyPostsReq, opts ...grpc.CallOption) (*ListApplyPostsRes, error)
 	BatchGetApplyPostsByPostIDs(ctx context.Context, in *BatchGetApplyPostsByPostIDsReq, opts ...grpc.CallOption) (*BatchGetApplyPostsByPostIDsRes, error)
 	CreateApplyPost(ctx context.Context, in *CreateApplyPostReq, opts ...grpc.CallOption) (*ApplyPost, error)
+	AcceptApplyPost(ctx context.Context, in *AcceptApplyPostReq, opts ...grpc.CallOption) (*ApplyPost, error)
+	DeclineApplyPost(ctx context.Context, in *DeclineApplyPostReq, opts ...grpc.CallOption) (*ApplyPost, error)
 	DeleteApplyPost(ctx context.Context, in *DeleteApplyPostReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetSagaStatus(ctx context.Context, in *GetSagaStatusReq, opts ...grpc.CallOption) (*SagaStatus, error)
+	WatchSaga(ctx context.Context, in *WatchSagaReq, opts ...grpc.CallOption) (PostService_WatchSagaClient, error)
 }
 
 type postServiceClient struct {
@@ -2340,12 +3821,30 @@ func (x *postServiceUpdatePostClient) CloseAndRecv() (*Post, error) {
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return m, nil
+	return m, nil
+}
+
+func (c *postServiceClient) DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostRes, error) {
+	out := new(DeletePostRes)
+	err := c.cc.Invoke(ctx, "/post.PostService/DeletePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) PublishPost(ctx context.Context, in *PublishPostReq, opts ...grpc.CallOption) (*CreatePostRes, error) {
+	out := new(CreatePostRes)
+	err := c.cc.Invoke(ctx, "/post.PostService/PublishPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *postServiceClient) DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/post.PostService/DeletePost", in, out, opts...)
+func (c *postServiceClient) CancelPost(ctx context.Context, in *CancelPostReq, opts ...grpc.CallOption) (*Post, error) {
+	out := new(Post)
+	err := c.cc.Invoke(ctx, "/post.PostService/CancelPost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2388,6 +3887,24 @@ func (c *postServiceClient) CreateApplyPost(ctx context.Context, in *CreateApply
 	return out, nil
 }
 
+func (c *postServiceClient) AcceptApplyPost(ctx context.Context, in *AcceptApplyPostReq, opts ...grpc.CallOption) (*ApplyPost, error) {
+	out := new(ApplyPost)
+	err := c.cc.Invoke(ctx, "/post.PostService/AcceptApplyPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) DeclineApplyPost(ctx context.Context, in *DeclineApplyPostReq, opts ...grpc.CallOption) (*ApplyPost, error) {
+	out := new(ApplyPost)
+	err := c.cc.Invoke(ctx, "/post.PostService/DeclineApplyPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) DeleteApplyPost(ctx context.Context, in *DeleteApplyPostReq, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/post.PostService/DeleteApplyPost", in, out, opts...)
@@ -2397,18 +3914,65 @@ func (c *postServiceClient) DeleteApplyPost(ctx context.Context, in *DeleteApply
 	return out, nil
 }
 
+func (c *postServiceClient) GetSagaStatus(ctx context.Context, in *GetSagaStatusReq, opts ...grpc.CallOption) (*SagaStatus, error) {
+	out := new(SagaStatus)
+	err := c.cc.Invoke(ctx, "/post.PostService/GetSagaStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) WatchSaga(ctx context.Context, in *WatchSagaReq, opts ...grpc.CallOption) (PostService_WatchSagaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PostService_serviceDesc.Streams[2], "/post.PostService/WatchSaga", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &postServiceWatchSagaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PostService_WatchSagaClient interface {
+	Recv() (*SagaStatus, error)
+	grpc.ClientStream
+}
+
+type postServiceWatchSagaClient struct {
+	grpc.ClientStream
+}
+
+func (x *postServiceWatchSagaClient) Recv() (*SagaStatus, error) {
+	m := new(SagaStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PostServiceServer is the server API for PostService service.
 type PostServiceServer interface {
 	GetPost(context.Context, *GetPostReq) (*Post, error)
 	ListPosts(context.Context, *ListPostsReq) (*ListPostsRes, error)
 	CreatePost(PostService_CreatePostServer) error
 	UpdatePost(PostService_UpdatePostServer) error
-	DeletePost(context.Context, *DeletePostReq) (*empty.Empty, error)
+	DeletePost(context.Context, *DeletePostReq) (*DeletePostRes, error)
+	PublishPost(context.Context, *PublishPostReq) (*CreatePostRes, error)
+	CancelPost(context.Context, *CancelPostReq) (*Post, error)
 	GetApplyPost(context.Context, *GetApplyPostReq) (*ApplyPost, error)
 	ListApplyPosts(context.Context, *ListApplyPostsReq) (*ListApplyPostsRes, error)
 	BatchGetApplyPostsByPostIDs(context.Context, *BatchGetApplyPostsByPostIDsReq) (*BatchGetApplyPostsByPostIDsRes, error)
 	CreateApplyPost(context.Context, *CreateApplyPostReq) (*ApplyPost, error)
+	AcceptApplyPost(context.Context, *AcceptApplyPostReq) (*ApplyPost, error)
+	DeclineApplyPost(context.Context, *DeclineApplyPostReq) (*ApplyPost, error)
 	DeleteApplyPost(context.Context, *DeleteApplyPostReq) (*empty.Empty, error)
+	GetSagaStatus(context.Context, *GetSagaStatusReq) (*SagaStatus, error)
+	WatchSaga(*WatchSagaReq, PostService_WatchSagaServer) error
 }
 
 // UnimplementedPostServiceServer can be embedded to have forward compatible implementations.
@@ -2427,9 +3991,15 @@ func (*UnimplementedPostServiceServer) CreatePost(PostService_CreatePostServer)
 func (*UnimplementedPostServiceServer) UpdatePost(PostService_UpdatePostServer) error {
 	return status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
 }
-func (*UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostReq) (*empty.Empty, error) {
+func (*UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostReq) (*DeletePostRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
 }
+func (*UnimplementedPostServiceServer) PublishPost(context.Context, *PublishPostReq) (*CreatePostRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPost not implemented")
+}
+func (*UnimplementedPostServiceServer) CancelPost(context.Context, *CancelPostReq) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPost not implemented")
+}
 func (*UnimplementedPostServiceServer) GetApplyPost(context.Context, *GetApplyPostReq) (*ApplyPost, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApplyPost not implemented")
 }
@@ -2442,9 +4012,21 @@ func (*UnimplementedPostServiceServer) BatchGetApplyPostsByPostIDs(context.Conte
 func (*UnimplementedPostServiceServer) CreateApplyPost(context.Context, *CreateApplyPostReq) (*ApplyPost, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateApplyPost not implemented")
 }
+func (*UnimplementedPostServiceServer) AcceptApplyPost(context.Context, *AcceptApplyPostReq) (*ApplyPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptApplyPost not implemented")
+}
+func (*UnimplementedPostServiceServer) DeclineApplyPost(context.Context, *DeclineApplyPostReq) (*ApplyPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineApplyPost not implemented")
+}
 func (*UnimplementedPostServiceServer) DeleteApplyPost(context.Context, *DeleteApplyPostReq) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplyPost not implemented")
 }
+func (*UnimplementedPostServiceServer) GetSagaStatus(context.Context, *GetSagaStatusReq) (*SagaStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSagaStatus not implemented")
+}
+func (*UnimplementedPostServiceServer) WatchSaga(*WatchSagaReq, PostService_WatchSagaServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSaga not implemented")
+}
 
 func RegisterPostServiceServer(s *grpc.Server, srv PostServiceServer) {
 	s.RegisterService(&_PostService_serviceDesc, srv)
@@ -2556,6 +4138,42 @@ func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_PublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).PublishPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/PublishPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).PublishPost(ctx, req.(*PublishPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_CancelPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CancelPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/CancelPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CancelPost(ctx, req.(*CancelPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_GetApplyPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetApplyPostReq)
 	if err := dec(in); err != nil {
@@ -2628,6 +4246,42 @@ func _PostService_CreateApplyPost_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_AcceptApplyPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptApplyPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).AcceptApplyPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/AcceptApplyPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).AcceptApplyPost(ctx, req.(*AcceptApplyPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeclineApplyPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineApplyPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeclineApplyPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/DeclineApplyPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeclineApplyPost(ctx, req.(*DeclineApplyPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_DeleteApplyPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteApplyPostReq)
 	if err := dec(in); err != nil {
@@ -2646,6 +4300,45 @@ func _PostService_DeleteApplyPost_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_GetSagaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSagaStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetSagaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostService/GetSagaStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetSagaStatus(ctx, req.(*GetSagaStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_WatchSaga_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSagaReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PostServiceServer).WatchSaga(m, &postServiceWatchSagaServer{stream})
+}
+
+type PostService_WatchSagaServer interface {
+	Send(*SagaStatus) error
+	grpc.ServerStream
+}
+
+type postServiceWatchSagaServer struct {
+	grpc.ServerStream
+}
+
+func (x *postServiceWatchSagaServer) Send(m *SagaStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _PostService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "post.PostService",
 	HandlerType: (*PostServiceServer)(nil),
@@ -2662,6 +4355,14 @@ var _PostService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _PostService_DeletePost_Handler,
 		},
+		{
+			MethodName: "PublishPost",
+			Handler:    _PostService_PublishPost_Handler,
+		},
+		{
+			MethodName: "CancelPost",
+			Handler:    _PostService_CancelPost_Handler,
+		},
 		{
 			MethodName: "GetApplyPost",
 			Handler:    _PostService_GetApplyPost_Handler,
@@ -2678,10 +4379,22 @@ var _PostService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateApplyPost",
 			Handler:    _PostService_CreateApplyPost_Handler,
 		},
+		{
+			MethodName: "AcceptApplyPost",
+			Handler:    _PostService_AcceptApplyPost_Handler,
+		},
+		{
+			MethodName: "DeclineApplyPost",
+			Handler:    _PostService_DeclineApplyPost_Handler,
+		},
 		{
 			MethodName: "DeleteApplyPost",
 			Handler:    _PostService_DeleteApplyPost_Handler,
 		},
+		{
+			MethodName: "GetSagaStatus",
+			Handler:    _PostService_GetSagaStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2694,6 +4407,191 @@ var _PostService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _PostService_UpdatePost_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchSaga",
+			Handler:       _PostService_WatchSaga_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "post.proto",
+}
+
+// PostAdminServiceClient is the client API for PostAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PostAdminServiceClient interface {
+	ListSagaTransitions(ctx context.Context, in *ListSagaTransitionsReq, opts ...grpc.CallOption) (*ListSagaTransitionsRes, error)
+	ListSagas(ctx context.Context, in *ListSagasReq, opts ...grpc.CallOption) (*ListSagasRes, error)
+	RetrySagaStep(ctx context.Context, in *RetrySagaStepReq, opts ...grpc.CallOption) (*SagaStatus, error)
+	ForceCompensate(ctx context.Context, in *ForceCompensateReq, opts ...grpc.CallOption) (*SagaStatus, error)
+}
+
+type postAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPostAdminServiceClient(cc grpc.ClientConnInterface) PostAdminServiceClient {
+	return &postAdminServiceClient{cc}
+}
+
+func (c *postAdminServiceClient) ListSagaTransitions(ctx context.Context, in *ListSagaTransitionsReq, opts ...grpc.CallOption) (*ListSagaTransitionsRes, error) {
+	out := new(ListSagaTransitionsRes)
+	err := c.cc.Invoke(ctx, "/post.PostAdminService/ListSagaTransitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postAdminServiceClient) ListSagas(ctx context.Context, in *ListSagasReq, opts ...grpc.CallOption) (*ListSagasRes, error) {
+	out := new(ListSagasRes)
+	err := c.cc.Invoke(ctx, "/post.PostAdminService/ListSagas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postAdminServiceClient) RetrySagaStep(ctx context.Context, in *RetrySagaStepReq, opts ...grpc.CallOption) (*SagaStatus, error) {
+	out := new(SagaStatus)
+	err := c.cc.Invoke(ctx, "/post.PostAdminService/RetrySagaStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postAdminServiceClient) ForceCompensate(ctx context.Context, in *ForceCompensateReq, opts ...grpc.CallOption) (*SagaStatus, error) {
+	out := new(SagaStatus)
+	err := c.cc.Invoke(ctx, "/post.PostAdminService/ForceCompensate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PostAdminServiceServer is the server API for PostAdminService service.
+type PostAdminServiceServer interface {
+	ListSagaTransitions(context.Context, *ListSagaTransitionsReq) (*ListSagaTransitionsRes, error)
+	ListSagas(context.Context, *ListSagasReq) (*ListSagasRes, error)
+	RetrySagaStep(context.Context, *RetrySagaStepReq) (*SagaStatus, error)
+	ForceCompensate(context.Context, *ForceCompensateReq) (*SagaStatus, error)
+}
+
+// UnimplementedPostAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPostAdminServiceServer struct {
+}
+
+func (*UnimplementedPostAdminServiceServer) ListSagaTransitions(context.Context, *ListSagaTransitionsReq) (*ListSagaTransitionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSagaTransitions not implemented")
+}
+func (*UnimplementedPostAdminServiceServer) ListSagas(context.Context, *ListSagasReq) (*ListSagasRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSagas not implemented")
+}
+func (*UnimplementedPostAdminServiceServer) RetrySagaStep(context.Context, *RetrySagaStepReq) (*SagaStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrySagaStep not implemented")
+}
+func (*UnimplementedPostAdminServiceServer) ForceCompensate(context.Context, *ForceCompensateReq) (*SagaStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCompensate not implemented")
+}
+
+func RegisterPostAdminServiceServer(s *grpc.Server, srv PostAdminServiceServer) {
+	s.RegisterService(&_PostAdminService_serviceDesc, srv)
+}
+
+func _PostAdminService_ListSagaTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSagaTransitionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostAdminServiceServer).ListSagaTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostAdminService/ListSagaTransitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostAdminServiceServer).ListSagaTransitions(ctx, req.(*ListSagaTransitionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostAdminService_ListSagas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSagasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostAdminServiceServer).ListSagas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostAdminService/ListSagas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostAdminServiceServer).ListSagas(ctx, req.(*ListSagasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostAdminService_RetrySagaStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrySagaStepReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostAdminServiceServer).RetrySagaStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostAdminService/RetrySagaStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostAdminServiceServer).RetrySagaStep(ctx, req.(*RetrySagaStepReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostAdminService_ForceCompensate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceCompensateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostAdminServiceServer).ForceCompensate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/post.PostAdminService/ForceCompensate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostAdminServiceServer).ForceCompensate(ctx, req.(*ForceCompensateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PostAdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "post.PostAdminService",
+	HandlerType: (*PostAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSagaTransitions",
+			Handler:    _PostAdminService_ListSagaTransitions_Handler,
+		},
+		{
+			MethodName: "ListSagas",
+			Handler:    _PostAdminService_ListSagas_Handler,
+		},
+		{
+			MethodName: "RetrySagaStep",
+			Handler:    _PostAdminService_RetrySagaStep_Handler,
+		},
+		{
+			MethodName: "ForceCompensate",
+			Handler:    _PostAdminService_ForceCompensate_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "post.proto",
 }
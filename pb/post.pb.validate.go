@@ -89,6 +89,20 @@ func (m *Post) Validate() error {
 		}
 	}
 
+	// no validation rules for Status
+
+	// no validation rules for CancelReason
+
+	if v, ok := interface{}(m.GetApplyDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostValidationError{
+				field:  "ApplyDeadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -179,6 +193,12 @@ func (m *ApplyPost) Validate() error {
 		}
 	}
 
+	// no validation rules for Status
+
+	// no validation rules for PartySize
+
+	// no validation rules for Note
+
 	return nil
 }
 
@@ -250,6 +270,8 @@ func (m *GetPostReq) Validate() error {
 		}
 	}
 
+	// no validation rules for RequesterId
+
 	return nil
 }
 
@@ -693,6 +715,29 @@ func (m *CreatePostReqInfo) Validate() error {
 		}
 	}
 
+	if t := m.GetApplyDeadline(); t != nil {
+		ts, err := ptypes.Timestamp(t)
+		if err != nil {
+			return CreatePostReqInfoValidationError{
+				field:  "ApplyDeadline",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+		}
+
+		now := time.Now()
+
+		if ts.Sub(now) <= 0 {
+			return CreatePostReqInfoValidationError{
+				field:  "ApplyDeadline",
+				reason: "value must be greater than now",
+			}
+		}
+
+	}
+
+	// no validation rules for Draft
+
 	return nil
 }
 
@@ -967,6 +1012,27 @@ func (m *UpdatePostReqInfo) Validate() error {
 
 	}
 
+	if t := m.GetApplyDeadline(); t != nil {
+		ts, err := ptypes.Timestamp(t)
+		if err != nil {
+			return UpdatePostReqInfoValidationError{
+				field:  "ApplyDeadline",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+		}
+
+		now := time.Now()
+
+		if ts.Sub(now) <= 0 {
+			return UpdatePostReqInfoValidationError{
+				field:  "ApplyDeadline",
+				reason: "value must be greater than now",
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -1207,6 +1273,8 @@ func (m *DeletePostRes) Validate() error {
 
 	// no validation rules for Success
 
+	// no validation rules for SagaId
+
 	return nil
 }
 
@@ -1264,6 +1332,171 @@ var _ interface {
 	ErrorName() string
 } = DeletePostResValidationError{}
 
+// Validate checks the field values on PublishPostReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PublishPostReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() < 1 {
+		return PublishPostReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	if m.GetUserId() < 1 {
+		return PublishPostReqValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	return nil
+}
+
+// PublishPostReqValidationError is the validation error returned by
+// PublishPostReq.Validate if the designated constraints aren't met.
+type PublishPostReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishPostReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishPostReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishPostReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishPostReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishPostReqValidationError) ErrorName() string { return "PublishPostReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PublishPostReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishPostReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishPostReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishPostReqValidationError{}
+
+// Validate checks the field values on CancelPostReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *CancelPostReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() < 1 {
+		return CancelPostReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	if m.GetUserId() < 1 {
+		return CancelPostReqValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 1000 {
+		return CancelPostReqValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 1000 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// CancelPostReqValidationError is the validation error returned by
+// CancelPostReq.Validate if the designated constraints aren't met.
+type CancelPostReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelPostReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelPostReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelPostReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelPostReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelPostReqValidationError) ErrorName() string { return "CancelPostReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CancelPostReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelPostReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelPostReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelPostReqValidationError{}
+
 // Validate checks the field values on GetApplyPostReq with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
@@ -1698,6 +1931,20 @@ func (m *CreateApplyPostReq) Validate() error {
 		}
 	}
 
+	if m.GetPartySize() < 0 {
+		return CreateApplyPostReqValidationError{
+			field:  "PartySize",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 500 {
+		return CreateApplyPostReqValidationError{
+			field:  "Note",
+			reason: "value length must be at most 500 runes",
+		}
+	}
+
 	return nil
 }
 
@@ -1757,27 +2004,34 @@ var _ interface {
 	ErrorName() string
 } = CreateApplyPostReqValidationError{}
 
-// Validate checks the field values on DeleteApplyPostReq with the rules
+// Validate checks the field values on AcceptApplyPostReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteApplyPostReq) Validate() error {
+func (m *AcceptApplyPostReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	if m.GetId() < 1 {
-		return DeleteApplyPostReqValidationError{
+		return AcceptApplyPostReqValidationError{
 			field:  "Id",
 			reason: "value must be greater than or equal to 1",
 		}
 	}
 
+	if m.GetUserId() < 1 {
+		return AcceptApplyPostReqValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
 	return nil
 }
 
-// DeleteApplyPostReqValidationError is the validation error returned by
-// DeleteApplyPostReq.Validate if the designated constraints aren't met.
-type DeleteApplyPostReqValidationError struct {
+// AcceptApplyPostReqValidationError is the validation error returned by
+// AcceptApplyPostReq.Validate if the designated constraints aren't met.
+type AcceptApplyPostReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1785,24 +2039,24 @@ type DeleteApplyPostReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteApplyPostReqValidationError) Field() string { return e.field }
+func (e AcceptApplyPostReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteApplyPostReqValidationError) Reason() string { return e.reason }
+func (e AcceptApplyPostReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteApplyPostReqValidationError) Cause() error { return e.cause }
+func (e AcceptApplyPostReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteApplyPostReqValidationError) Key() bool { return e.key }
+func (e AcceptApplyPostReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteApplyPostReqValidationError) ErrorName() string {
-	return "DeleteApplyPostReqValidationError"
+func (e AcceptApplyPostReqValidationError) ErrorName() string {
+	return "AcceptApplyPostReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteApplyPostReqValidationError) Error() string {
+func (e AcceptApplyPostReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1814,14 +2068,14 @@ func (e DeleteApplyPostReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteApplyPostReq.%s: %s%s",
+		"invalid %sAcceptApplyPostReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteApplyPostReqValidationError{}
+var _ error = AcceptApplyPostReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1829,53 +2083,1010 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteApplyPostReqValidationError{}
+} = AcceptApplyPostReqValidationError{}
 
-// Validate checks the field values on ListPostsReq_Filter with the rules
+// Validate checks the field values on DeclineApplyPostReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListPostsReq_Filter) Validate() error {
+func (m *DeclineApplyPostReq) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetPrefectureId() > 47 {
-		return ListPostsReq_FilterValidationError{
-			field:  "PrefectureId",
-			reason: "value must be less than or equal to 47",
+	if m.GetId() < 1 {
+		return DeclineApplyPostReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
 		}
 	}
 
-	if m.GetFishingSpotTypeId() > 4 {
-		return ListPostsReq_FilterValidationError{
-			field:  "FishingSpotTypeId",
-			reason: "value must be less than or equal to 4",
+	if m.GetUserId() < 1 {
+		return DeclineApplyPostReqValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
 		}
 	}
 
-	_ListPostsReq_Filter_FishTypeIds_Unique := make(map[int64]struct{}, len(m.GetFishTypeIds()))
+	return nil
+}
 
-	for idx, item := range m.GetFishTypeIds() {
-		_, _ = idx, item
+// DeclineApplyPostReqValidationError is the validation error returned by
+// DeclineApplyPostReq.Validate if the designated constraints aren't met.
+type DeclineApplyPostReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if _, exists := _ListPostsReq_Filter_FishTypeIds_Unique[item]; exists {
-			return ListPostsReq_FilterValidationError{
-				field:  fmt.Sprintf("FishTypeIds[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-		} else {
-			_ListPostsReq_Filter_FishTypeIds_Unique[item] = struct{}{}
-		}
+// Field function returns field value.
+func (e DeclineApplyPostReqValidationError) Field() string { return e.field }
 
-		// no validation rules for FishTypeIds[idx]
-	}
+// Reason function returns reason value.
+func (e DeclineApplyPostReqValidationError) Reason() string { return e.reason }
 
-	if v, ok := interface{}(m.GetMeetingAtFrom()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListPostsReq_FilterValidationError{
-				field:  "MeetingAtFrom",
-				reason: "embedded message failed validation",
-				cause:  err,
+// Cause function returns cause value.
+func (e DeclineApplyPostReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeclineApplyPostReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeclineApplyPostReqValidationError) ErrorName() string {
+	return "DeclineApplyPostReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeclineApplyPostReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeclineApplyPostReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeclineApplyPostReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeclineApplyPostReqValidationError{}
+
+// Validate checks the field values on DeleteApplyPostReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteApplyPostReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() < 1 {
+		return DeleteApplyPostReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	return nil
+}
+
+// DeleteApplyPostReqValidationError is the validation error returned by
+// DeleteApplyPostReq.Validate if the designated constraints aren't met.
+type DeleteApplyPostReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteApplyPostReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteApplyPostReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteApplyPostReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteApplyPostReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteApplyPostReqValidationError) ErrorName() string {
+	return "DeleteApplyPostReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteApplyPostReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteApplyPostReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteApplyPostReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteApplyPostReqValidationError{}
+
+// Validate checks the field values on SagaStatus with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *SagaStatus) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SagaId
+
+	// no validation rules for SagaType
+
+	// no validation rules for CurrentState
+
+	// no validation rules for RejectReason
+
+	// no validation rules for Finished
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SagaStatusValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SagaStatusValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RetryCount
+
+	return nil
+}
+
+// SagaStatusValidationError is the validation error returned by
+// SagaStatus.Validate if the designated constraints aren't met.
+type SagaStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SagaStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SagaStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SagaStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SagaStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SagaStatusValidationError) ErrorName() string { return "SagaStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SagaStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSagaStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SagaStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SagaStatusValidationError{}
+
+// Validate checks the field values on GetSagaStatusReq with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetSagaStatusReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetSagaId()) < 1 {
+		return GetSagaStatusReqValidationError{
+			field:  "SagaId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// GetSagaStatusReqValidationError is the validation error returned by
+// GetSagaStatusReq.Validate if the designated constraints aren't met.
+type GetSagaStatusReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSagaStatusReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSagaStatusReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSagaStatusReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSagaStatusReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSagaStatusReqValidationError) ErrorName() string { return "GetSagaStatusReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSagaStatusReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSagaStatusReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSagaStatusReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSagaStatusReqValidationError{}
+
+// Validate checks the field values on WatchSagaReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *WatchSagaReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetSagaId()) < 1 {
+		return WatchSagaReqValidationError{
+			field:  "SagaId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// WatchSagaReqValidationError is the validation error returned by
+// WatchSagaReq.Validate if the designated constraints aren't met.
+type WatchSagaReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchSagaReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchSagaReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchSagaReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchSagaReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchSagaReqValidationError) ErrorName() string { return "WatchSagaReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchSagaReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchSagaReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchSagaReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchSagaReqValidationError{}
+
+// Validate checks the field values on SagaTransition with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SagaTransition) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for SagaId
+
+	// no validation rules for Event
+
+	// no validation rules for FromState
+
+	// no validation rules for ToState
+
+	// no validation rules for Payload
+
+	// no validation rules for ErrorMessage
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SagaTransitionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SagaTransitionValidationError is the validation error returned by
+// SagaTransition.Validate if the designated constraints aren't met.
+type SagaTransitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SagaTransitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SagaTransitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SagaTransitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SagaTransitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SagaTransitionValidationError) ErrorName() string { return "SagaTransitionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SagaTransitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSagaTransition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SagaTransitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SagaTransitionValidationError{}
+
+// Validate checks the field values on ListSagaTransitionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSagaTransitionsReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetSagaId()) < 1 {
+		return ListSagaTransitionsReqValidationError{
+			field:  "SagaId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// ListSagaTransitionsReqValidationError is the validation error returned by
+// ListSagaTransitionsReq.Validate if the designated constraints aren't met.
+type ListSagaTransitionsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSagaTransitionsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSagaTransitionsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSagaTransitionsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSagaTransitionsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSagaTransitionsReqValidationError) ErrorName() string {
+	return "ListSagaTransitionsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSagaTransitionsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSagaTransitionsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSagaTransitionsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSagaTransitionsReqValidationError{}
+
+// Validate checks the field values on ListSagaTransitionsRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSagaTransitionsRes) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTransitions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSagaTransitionsResValidationError{
+					field:  fmt.Sprintf("Transitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListSagaTransitionsResValidationError is the validation error returned by
+// ListSagaTransitionsRes.Validate if the designated constraints aren't met.
+type ListSagaTransitionsResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSagaTransitionsResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSagaTransitionsResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSagaTransitionsResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSagaTransitionsResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSagaTransitionsResValidationError) ErrorName() string {
+	return "ListSagaTransitionsResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSagaTransitionsResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSagaTransitionsRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSagaTransitionsResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSagaTransitionsResValidationError{}
+
+// Validate checks the field values on ListSagasReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ListSagasReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SagaType
+
+	if v, ok := interface{}(m.GetUpdatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListSagasReqValidationError{
+				field:  "UpdatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() > 100 {
+		return ListSagasReqValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+	}
+
+	return nil
+}
+
+// ListSagasReqValidationError is the validation error returned by
+// ListSagasReq.Validate if the designated constraints aren't met.
+type ListSagasReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSagasReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSagasReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSagasReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSagasReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSagasReqValidationError) ErrorName() string { return "ListSagasReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListSagasReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSagasReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSagasReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSagasReqValidationError{}
+
+// Validate checks the field values on ListSagasRes with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ListSagasRes) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetSagas() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSagasResValidationError{
+					field:  fmt.Sprintf("Sagas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListSagasResValidationError is the validation error returned by
+// ListSagasRes.Validate if the designated constraints aren't met.
+type ListSagasResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSagasResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSagasResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSagasResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSagasResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSagasResValidationError) ErrorName() string { return "ListSagasResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListSagasResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSagasRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSagasResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSagasResValidationError{}
+
+// Validate checks the field values on RetrySagaStepReq with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *RetrySagaStepReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetSagaId()) < 1 {
+		return RetrySagaStepReqValidationError{
+			field:  "SagaId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// RetrySagaStepReqValidationError is the validation error returned by
+// RetrySagaStepReq.Validate if the designated constraints aren't met.
+type RetrySagaStepReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetrySagaStepReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetrySagaStepReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetrySagaStepReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetrySagaStepReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetrySagaStepReqValidationError) ErrorName() string { return "RetrySagaStepReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetrySagaStepReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetrySagaStepReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetrySagaStepReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetrySagaStepReqValidationError{}
+
+// Validate checks the field values on ForceCompensateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ForceCompensateReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetSagaId()) < 1 {
+		return ForceCompensateReqValidationError{
+			field:  "SagaId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 1000 {
+		return ForceCompensateReqValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 1000 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// ForceCompensateReqValidationError is the validation error returned by
+// ForceCompensateReq.Validate if the designated constraints aren't met.
+type ForceCompensateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceCompensateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceCompensateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceCompensateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceCompensateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceCompensateReqValidationError) ErrorName() string {
+	return "ForceCompensateReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceCompensateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceCompensateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceCompensateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceCompensateReqValidationError{}
+
+// Validate checks the field values on ListPostsReq_Filter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListPostsReq_Filter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetPrefectureId() > 47 {
+		return ListPostsReq_FilterValidationError{
+			field:  "PrefectureId",
+			reason: "value must be less than or equal to 47",
+		}
+	}
+
+	if m.GetFishingSpotTypeId() > 4 {
+		return ListPostsReq_FilterValidationError{
+			field:  "FishingSpotTypeId",
+			reason: "value must be less than or equal to 4",
+		}
+	}
+
+	_ListPostsReq_Filter_FishTypeIds_Unique := make(map[int64]struct{}, len(m.GetFishTypeIds()))
+
+	for idx, item := range m.GetFishTypeIds() {
+		_, _ = idx, item
+
+		if _, exists := _ListPostsReq_Filter_FishTypeIds_Unique[item]; exists {
+			return ListPostsReq_FilterValidationError{
+				field:  fmt.Sprintf("FishTypeIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_ListPostsReq_Filter_FishTypeIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for FishTypeIds[idx]
+	}
+
+	if v, ok := interface{}(m.GetMeetingAtFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPostsReq_FilterValidationError{
+				field:  "MeetingAtFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
 	}
@@ -1908,6 +3119,10 @@ func (m *ListPostsReq_Filter) Validate() error {
 
 	// no validation rules for UserId
 
+	// no validation rules for RequesterId
+
+	// no validation rules for Draft
+
 	return nil
 }
 
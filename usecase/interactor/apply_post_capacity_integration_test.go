@@ -0,0 +1,140 @@
+//go:build integration
+// +build integration
+
+package interactor_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/infrastructure"
+	"github.com/ezio1119/fishapp-post/infrastructure/sqlhandler"
+	"github.com/ezio1119/fishapp-post/interfaces/repo"
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/interactor"
+	"github.com/ezio1119/fishapp-post/usecase/interactor/saga"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// docker-composeのMySQLに対して実行する。先にmake migrateでテーブルを作っておく
+// コンテナの中ではmake integrationtest、ホストからはDB_HOST=127.0.0.1 DB_PORT=7306を付けて
+// go test -tags integration ./usecase/interactor/ で実行する
+
+// チャットルームへの追加と削除は常に成功させる
+type nopChatRepo struct{}
+
+func (nopChatRepo) CreateMember(ctx context.Context, postID int64, userID int64) error { return nil }
+func (nopChatRepo) DeleteMember(ctx context.Context, postID int64, userID int64) error { return nil }
+
+// 定員より多い応募の作成と承認を同時に行っても、承認済みの人数の合計が定員を超えないことを確かめる
+func TestIntegrationApplyPostCapacity(t *testing.T) {
+	const (
+		maxApply   = 5
+		applicants = 30
+	)
+
+	db, err := infrastructure.NewMySQLDB()
+	if err != nil {
+		t.Fatalf("failed to connect db: %s", err)
+	}
+	defer db.Close()
+
+	h := sqlhandler.NewSqlHandler(db)
+	pr := repo.NewPostRepo(h)
+	ar := repo.NewApplyPostRepo(h)
+	or := repo.NewOutboxRepo(h)
+	tr := repo.NewTransactionRepo(h)
+
+	so := saga.NewOrchestrator(or, repo.NewSagaInstanceRepo(h), repo.NewSagaTransitionRepo(h), repo.NewReceivedMessageRepo(h), tr)
+	so.Register(saga.NewApplyPostSagaDefinition(ar, nopChatRepo{}))
+
+	i := interactor.NewPostInteractor(pr, nil, ar, tr, or, so, 30*time.Second)
+
+	ctx := context.Background()
+	now := time.Now()
+	p := &models.Post{
+		Title:             "capacity test",
+		Content:           "capacity test",
+		FishingSpotTypeID: 1,
+		PostsFishTypes:    []*models.PostsFishType{{FishTypeID: 1, CreatedAt: now, UpdatedAt: now}},
+		PrefectureID:      1,
+		MeetingPlaceID:    "capacity_test",
+		MeetingAt:         now.Add(48 * time.Hour),
+		ApplyDeadline:     now.Add(24 * time.Hour),
+		MaxApply:          maxApply,
+		UserID:            1,
+		Status:            models.PostStatusPublished,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if err := pr.CreatePost(ctx, p); err != nil {
+		t.Fatalf("failed to create post: %s", err)
+	}
+
+	// 応募は投稿と一緒に消える。サガとイベントの行は投稿のidで探して消す
+	defer func() {
+		postID := strconv.FormatInt(p.ID, 10)
+		queries := []string{
+			"DELETE FROM outbox WHERE aggregate_type = 'apply.post' AND aggregate_id IN (SELECT id FROM apply_posts WHERE post_id = ?)",
+			"DELETE FROM saga_transitions WHERE saga_id IN (SELECT id FROM saga_instance WHERE saga_data->>'$.postId' = ?)",
+			"DELETE FROM saga_instance WHERE saga_data->>'$.postId' = ?",
+			"DELETE FROM posts WHERE id = ?",
+		}
+		for _, q := range queries {
+			if _, err := db.Exec(q, postID); err != nil {
+				t.Errorf("failed to clean up: %s", err)
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for n := 0; n < applicants; n++ {
+		wg.Add(1)
+		go func(userID int64, partySize int64) {
+			defer wg.Done()
+
+			a := &models.ApplyPost{PostID: p.ID, UserID: userID, PartySize: partySize}
+			if err := i.CreateApplyPost(ctx, a); err != nil {
+				t.Errorf("failed to create apply post of user id=%d: %s", userID, err)
+				return
+			}
+
+			// 他の応募の作成や承認と同時に承認する。空き待ちや定員を超える応募の承認は断られる
+			if _, err := i.AcceptApplyPost(ctx, a.ID, p.UserID); err != nil && status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("failed to accept apply post id=%d: %s", a.ID, err)
+			}
+		}(int64(1000+n), int64(n%2+1))
+	}
+	wg.Wait()
+
+	list, err := ar.ListApplyPostsByPostID(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("failed to list apply posts: %s", err)
+	}
+
+	var accepted int64
+	for _, a := range list {
+		if a.Status == models.ApplyPostStatusAccepted {
+			accepted += a.PartySize
+		}
+	}
+
+	if accepted > maxApply {
+		t.Errorf("accepted party_size %d exceeds max_apply %d", accepted, maxApply)
+	}
+	if accepted == 0 {
+		t.Errorf("no apply post was accepted")
+	}
+
+	cnt, err := ar.CountAcceptedSeatsByPostID(ctx, p.ID)
+	if err != nil {
+		t.Fatalf("failed to count accepted seats: %s", err)
+	}
+	if cnt != accepted {
+		t.Errorf("CountAcceptedSeatsByPostID returned %d, want %d", cnt, accepted)
+	}
+}
@@ -1,89 +1,164 @@
 package interactor
 
 import (
+	"context"
 	"strconv"
-	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
 	"github.com/ezio1119/fishapp-post/pb"
-	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func newApplyPostCreatedEvent(a *models.ApplyPost) (*models.Outbox, error) {
-	now := time.Now()
-	aP, err := convApplyPostProto(a)
+func newPostUpdatedEvent(ctx context.Context, before *models.Post, after *models.Post) (*models.Outbox, error) {
+	bPost, err := convPostProto(before)
 	if err != nil {
 		return nil, err
 	}
 
-	applyPostCreated, err := protojson.Marshal(&pb.ApplyPostCreated{ApplyPost: aP})
+	aPost, err := convPostProto(after)
 	if err != nil {
 		return nil, err
 	}
 
-	event := &models.Outbox{
-		ID:            uuid.New().String(),
-		EventType:     "apply.post.created",
-		EventData:     applyPostCreated,
-		AggregateID:   strconv.FormatInt(a.ID, 10),
-		AggregateType: "apply.post",
-		Channel:       "apply.post.created",
-		CreatedAt:     now,
-		UpdatedAt:     now,
+	eventData, err := protojson.Marshal(&pb.PostUpdated{
+		Before:        bPost,
+		After:         aPost,
+		ChangedFields: changedPostFields(before, after),
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	event := models.NewOutbox(ctx, "post.updated", "post.updated", eventData)
+	event.AggregateID = strconv.FormatInt(aPost.Id, 10)
+	event.AggregateType = "post"
+
 	return event, nil
 }
 
-func newApplyPostDeletedEvent(a *models.ApplyPost) (*models.Outbox, error) {
-	now := time.Now()
-	aP, err := convApplyPostProto(a)
+func newPostClosedEvent(ctx context.Context, p *models.Post) (*models.Outbox, error) {
+	pPost, err := convPostProto(p)
 	if err != nil {
 		return nil, err
 	}
 
-	applyPostDeleted, err := protojson.Marshal(&pb.ApplyPostDeleted{ApplyPost: aP})
+	eventData, err := protojson.Marshal(&pb.PostClosed{Post: pPost})
 	if err != nil {
 		return nil, err
 	}
 
-	event := &models.Outbox{
-		ID:            uuid.New().String(),
-		EventType:     "apply.post.deleted",
-		EventData:     applyPostDeleted,
-		AggregateID:   strconv.FormatInt(a.ID, 10),
-		AggregateType: "apply.post",
-		Channel:       "apply.post.deleted",
-		CreatedAt:     now,
-		UpdatedAt:     now,
-	}
+	event := models.NewOutbox(ctx, "post.closed", "post.closed", eventData)
+	event.AggregateID = strconv.FormatInt(pPost.Id, 10)
+	event.AggregateType = "post"
 
 	return event, nil
 }
 
-func newPostDeletedEvent(p *models.Post) (*models.Outbox, error) {
-	now := time.Now()
+func newPostCancelledEvent(ctx context.Context, p *models.Post, applicantUserIDs []int64) (*models.Outbox, error) {
 	pPost, err := convPostProto(p)
 	if err != nil {
 		return nil, err
 	}
 
-	eventData, err := protojson.Marshal(&pb.PostDeleted{Post: pPost})
+	eventData, err := protojson.Marshal(&pb.PostCancelled{
+		Post:             pPost,
+		Reason:           p.CancelReason,
+		ApplicantUserIds: applicantUserIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.NewOutbox(ctx, "post.cancelled", "post.cancelled", eventData)
+	event.AggregateID = strconv.FormatInt(pPost.Id, 10)
+	event.AggregateType = "post"
+
+	return event, nil
+}
+
+func newApplyPostCreatedEvent(ctx context.Context, a *models.ApplyPost) (*models.Outbox, error) {
+	aProto, err := convApplyPostProto(a)
+	if err != nil {
+		return nil, err
+	}
+
+	eventData, err := protojson.Marshal(&pb.ApplyPostCreated{ApplyPost: aProto})
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.NewOutbox(ctx, "apply.post.created", "apply.post.created", eventData)
+	event.AggregateID = strconv.FormatInt(aProto.Id, 10)
+	event.AggregateType = "apply.post"
+
+	return event, nil
+}
+
+func newApplyPostDeclinedEvent(ctx context.Context, a *models.ApplyPost) (*models.Outbox, error) {
+	aProto, err := convApplyPostProto(a)
 	if err != nil {
 		return nil, err
 	}
 
-	event := &models.Outbox{
-		ID:            uuid.New().String(),
-		EventType:     "post.deleted",
-		EventData:     eventData,
-		AggregateID:   strconv.FormatInt(pPost.Id, 10),
-		AggregateType: "post",
-		Channel:       "post.deleted",
-		CreatedAt:     now,
-		UpdatedAt:     now,
+	eventData, err := protojson.Marshal(&pb.ApplyPostDeclined{ApplyPost: aProto})
+	if err != nil {
+		return nil, err
 	}
 
+	event := models.NewOutbox(ctx, "apply.post.declined", "apply.post.declined", eventData)
+	event.AggregateID = strconv.FormatInt(aProto.Id, 10)
+	event.AggregateType = "apply.post"
+
 	return event, nil
 }
+
+// 更新で値が変わったフィールド名をprotoのフィールド名で返す
+func changedPostFields(before *models.Post, after *models.Post) []string {
+	fields := []string{}
+	if before.Title != after.Title {
+		fields = append(fields, "title")
+	}
+	if before.Content != after.Content {
+		fields = append(fields, "content")
+	}
+	if before.FishingSpotTypeID != after.FishingSpotTypeID {
+		fields = append(fields, "fishing_spot_type_id")
+	}
+	if !equalInt64s(models.ConvPostsFishTypeIDs(before.PostsFishTypes), models.ConvPostsFishTypeIDs(after.PostsFishTypes)) {
+		fields = append(fields, "fish_type_ids")
+	}
+	if before.PrefectureID != after.PrefectureID {
+		fields = append(fields, "prefecture_id")
+	}
+	if before.MeetingPlaceID != after.MeetingPlaceID {
+		fields = append(fields, "meeting_place_id")
+	}
+	if !before.MeetingAt.Equal(after.MeetingAt) {
+		fields = append(fields, "meeting_at")
+	}
+	if !before.ApplyDeadline.Equal(after.ApplyDeadline) {
+		fields = append(fields, "apply_deadline")
+	}
+	if before.MaxApply != after.MaxApply {
+		fields = append(fields, "max_apply")
+	}
+	return fields
+}
+
+// 順番は無視して同じ要素を持っているか
+func equalInt64s(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	cnt := make(map[int64]int, len(a))
+	for _, v := range a {
+		cnt[v]++
+	}
+	for _, v := range b {
+		if cnt[v] == 0 {
+			return false
+		}
+		cnt[v]--
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+package interactor
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type OutboxInteractor interface {
+	RelayOutboxes(ctx context.Context, maxAttempts int64, num int64) (int64, error)
+	PurgeOutboxes(ctx context.Context, retention time.Duration, num int64) (int64, error)
+	PurgeReceivedMessages(ctx context.Context, retention time.Duration, num int64) (int64, error)
+}
+
+type outboxInteractor struct {
+	outboxRepo          repo.OutboxRepo
+	receivedMessageRepo repo.ReceivedMessageRepo
+	eventRepo           repo.EventRepo
+	transactionRepo     repo.TransactionRepo
+	ctxTimeout          time.Duration
+}
+
+func NewOutboxInteractor(
+	or repo.OutboxRepo,
+	rr repo.ReceivedMessageRepo,
+	er repo.EventRepo,
+	tr repo.TransactionRepo,
+	timeout time.Duration,
+) OutboxInteractor {
+	return &outboxInteractor{or, rr, er, tr, timeout}
+}
+
+// 未発行のoutboxを最大num件natsに発行して、発行済みにした件数を返す
+// 発行できなかった行は試行回数を増やして飛ばし、maxAttempts回失敗した行はそれ以降取り出さない
+func (i *outboxInteractor) RelayOutboxes(ctx context.Context, maxAttempts int64, num int64) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	ctx, err := i.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if recover() != nil {
+			i.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	list, err := i.outboxRepo.ListUnpublishedOutboxes(ctx, maxAttempts, num)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return 0, err
+	}
+
+	var cnt int64
+	var relayErr error
+	// 同じ集約のイベントの順番が入れ替わらないように、直前の行がこのバッチで発行済みになった行だけを発行する
+	// 直前の行が失敗した場合や、発行を諦めた場合、他のレプリカが発行中の場合は、その集約の後続の行を発行しない
+	published := map[int64]bool{}
+	for _, o := range list {
+		if o.PrevSeq != 0 && !published[o.PrevSeq] {
+			continue
+		}
+
+		if err := i.publishOutbox(ctx, o); err != nil {
+			relayErr = err
+
+			if err := i.outboxRepo.MarkOutboxFailed(ctx, o.ID, err.Error()); err != nil {
+				log.Printf("error failed mark outbox id=%s failed: %s", o.ID, err)
+				break
+			}
+			if o.Attempts+1 >= maxAttempts {
+				log.Printf("error outbox id=%s failed %d times. give up publishing it and later events of %s %s: %s", o.ID, o.Attempts+1, o.AggregateType, o.AggregateID, err)
+			}
+			continue
+		}
+
+		if err := i.outboxRepo.MarkOutboxPublished(ctx, o.ID, time.Now()); err != nil {
+			relayErr = err
+			break
+		}
+		published[o.Seq] = true
+		cnt++
+	}
+
+	// 途中で失敗しても、それまでに発行したものは発行済みとしてコミットする
+	if _, err := i.transactionRepo.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return cnt, relayErr
+}
+
+func (i *outboxInteractor) publishOutbox(ctx context.Context, o *models.Outbox) error {
+	e, err := convEventProto(o)
+	if err != nil {
+		return err
+	}
+
+	data, err := protojson.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	return i.eventRepo.PublishEvent(ctx, o.Channel, data)
+}
+
+// retentionより前に発行済みになったoutboxを削除して、削除した件数を返す
+func (i *outboxInteractor) PurgeOutboxes(ctx context.Context, retention time.Duration, num int64) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	before := time.Now().Add(-retention)
+
+	var total int64
+	for {
+		cnt, err := i.outboxRepo.DeletePublishedOutboxes(ctx, before, num)
+		if err != nil {
+			return total, err
+		}
+		total += cnt
+
+		if cnt < num {
+			return total, nil
+		}
+	}
+}
+
+// retentionより前に受信したメッセージの記録を削除して、削除した件数を返す
+// 重複の判定に使うので、retentionは再配信が止まるまでの時間より長くする
+func (i *outboxInteractor) PurgeReceivedMessages(ctx context.Context, retention time.Duration, num int64) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	before := time.Now().Add(-retention)
+
+	var total int64
+	for {
+		cnt, err := i.receivedMessageRepo.DeleteReceivedMessages(ctx, before, num)
+		if err != nil {
+			return total, err
+		}
+		total += cnt
+
+		if cnt < num {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package interactor
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+)
+
+type PostCloseInteractor interface {
+	ClosePastPosts(ctx context.Context, num int64) (int64, error)
+}
+
+type postCloseInteractor struct {
+	postRepo        repo.PostRepo
+	outboxRepo      repo.OutboxRepo
+	transactionRepo repo.TransactionRepo
+	ctxTimeout      time.Duration
+}
+
+func NewPostCloseInteractor(
+	pr repo.PostRepo,
+	or repo.OutboxRepo,
+	tr repo.TransactionRepo,
+	timeout time.Duration,
+) PostCloseInteractor {
+	return &postCloseInteractor{pr, or, tr, timeout}
+}
+
+// meeting_atを過ぎた公開中の投稿を最大num件締め切って、締め切った件数を返す
+func (i *postCloseInteractor) ClosePastPosts(ctx context.Context, num int64) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	// 読んでから締め切るまでにmeeting_atを変えられた投稿を締め切らないように、同じ基準の時刻で確かめ直す
+	before := time.Now()
+
+	list, err := i.postRepo.ListPublishedPostsMeetingBefore(ctx, before, num)
+	if err != nil {
+		return 0, err
+	}
+
+	var cnt int64
+	for _, p := range list {
+		// 1件失敗しても残りは締め切る。失敗した投稿は次回また対象になる
+		closed, err := i.closePost(ctx, p.ID, before)
+		if err != nil {
+			log.Printf("error failed close post id=%d: %s", p.ID, err)
+			continue
+		}
+		if closed {
+			cnt++
+		}
+	}
+
+	return cnt, nil
+}
+
+// 締め切れた場合はtrueを返す。一覧を読んだ後に変わった投稿は締め切らずにfalseを返す
+func (i *postCloseInteractor) closePost(ctx context.Context, id int64, before time.Time) (bool, error) {
+	ctx, err := i.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if recover() != nil {
+			i.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	closed, err := i.postRepo.ClosePost(ctx, id, before, time.Now())
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return false, err
+	}
+
+	if !closed {
+		i.transactionRepo.Roolback(ctx)
+		return false, nil
+	}
+
+	// 一覧を読んだ後に更新された内容をイベントに入れるように、締め切った行を読み直す
+	p, err := i.postRepo.GetPostByID(ctx, id)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return false, err
+	}
+
+	event, err := newPostClosedEvent(ctx, p)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return false, err
+	}
+
+	if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return false, err
+	}
+
+	if _, err := i.transactionRepo.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
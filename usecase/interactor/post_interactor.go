@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/ezio1119/fishapp-post/conf"
@@ -16,27 +17,32 @@ import (
 )
 
 type PostInteractor interface {
-	GetPost(ctx context.Context, id int64) (*models.Post, error)
-	ListPosts(ctx context.Context, p *models.Post, pageSize int64, pageToken string, filter *models.PostFilter) ([]*models.Post, string, error)
+	GetPost(ctx context.Context, id int64, requesterID int64) (*models.Post, error)
+	ListPosts(ctx context.Context, p *models.Post, pageSize int64, pageToken string, filter *models.PostFilter, requesterID int64) ([]*models.Post, string, error)
 	CreatePost(ctx context.Context, p *models.Post, imageBufs []*bytes.Buffer) (string, error)
+	CreateDraftPost(ctx context.Context, p *models.Post, imageBufs []*bytes.Buffer) error
+	PublishPost(ctx context.Context, id int64, userID int64) (*models.Post, string, error)
 	UpdatePost(ctx context.Context, p *models.Post, imageBufs []*bytes.Buffer, deleteImageIDs []int64) error
-	DeletePost(ctx context.Context, id int64) error
+	DeletePost(ctx context.Context, id int64) (string, error)
+	CancelPost(ctx context.Context, id int64, userID int64, reason string) (*models.Post, error)
 
 	GetApplyPost(ctx context.Context, id int64) (*models.ApplyPost, error)
 	ListApplyPosts(ctx context.Context, applyPost *models.ApplyPost) ([]*models.ApplyPost, error)
 	BatchGetApplyPostsByPostIDs(ctx context.Context, postIDs []int64) ([]*models.ApplyPost, error)
 	CreateApplyPost(ctx context.Context, applyPost *models.ApplyPost) error
+	AcceptApplyPost(ctx context.Context, id int64, userID int64) (*models.ApplyPost, error)
+	DeclineApplyPost(ctx context.Context, id int64, userID int64) (*models.ApplyPost, error)
 	DeleteApplyPost(ctx context.Context, id int64) error
 }
 
 type postInteractor struct {
-	postRepo              repo.PostRepo
-	imageRepo             repo.ImageRepo
-	applyPostRepo         repo.ApplyPostRepo
-	transactionRepo       repo.TransactionRepo
-	outboxRepo            repo.OutboxRepo
-	createPostSagaManager *saga.CreatePostSagaManager
-	ctxTimeout            time.Duration
+	postRepo         repo.PostRepo
+	imageRepo        repo.ImageRepo
+	applyPostRepo    repo.ApplyPostRepo
+	transactionRepo  repo.TransactionRepo
+	outboxRepo       repo.OutboxRepo
+	sagaOrchestrator *saga.Orchestrator
+	ctxTimeout       time.Duration
 }
 
 func NewPostInteractor(
@@ -45,26 +51,51 @@ func NewPostInteractor(
 	ar repo.ApplyPostRepo,
 	tr repo.TransactionRepo,
 	or repo.OutboxRepo,
-	sm *saga.CreatePostSagaManager,
+	so *saga.Orchestrator,
 	timeout time.Duration,
 ) PostInteractor {
-	return &postInteractor{pr, ir, ar, tr, or, sm, timeout}
+	return &postInteractor{pr, ir, ar, tr, or, so, timeout}
 }
 
-func (i *postInteractor) GetPost(ctx context.Context, id int64) (*models.Post, error) {
+// 公開されていない投稿は投稿者本人にだけ返す
+func (i *postInteractor) GetPost(ctx context.Context, id int64, requesterID int64) (*models.Post, error) {
 	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
 	defer cancel()
 	p, err := i.postRepo.GetPostByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if p.Status != models.PostStatusPublished && p.UserID != requesterID {
+		return nil, status.Errorf(codes.NotFound, "post with id='%d' is not found", id)
+	}
 	return p, nil
 }
 
-func (i *postInteractor) ListPosts(ctx context.Context, p *models.Post, pageSize int64, pageToken string, f *models.PostFilter) ([]*models.Post, string, error) {
+// 投稿者本人が自分の投稿を絞り込んだ場合以外は、公開された投稿だけを返す
+// 下書きは投稿者本人がdraftを指定した場合にだけ返す
+func (i *postInteractor) ListPosts(ctx context.Context, p *models.Post, pageSize int64, pageToken string, f *models.PostFilter, requesterID int64) ([]*models.Post, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
 	defer cancel()
 
+	isOwner := p.UserID != 0 && p.UserID == requesterID
+
+	switch {
+	case f.Draft && !isOwner:
+		return nil, "", status.Error(codes.PermissionDenied, "drafts can only be listed by the owner")
+	case f.Draft:
+		f.Statuses = []string{models.PostStatusDraft}
+	case isOwner:
+		f.Statuses = []string{
+			models.PostStatusPending,
+			models.PostStatusPublished,
+			models.PostStatusRejected,
+			models.PostStatusClosed,
+			models.PostStatusCancelled,
+		}
+	default:
+		f.Statuses = []string{models.PostStatusPublished}
+	}
+
 	fmt.Printf("post: %#v\npageSize: %#v\npageToken: %#v\nPostFilter: %#v\n", p, pageSize, pageToken, f)
 	if pageSize == 0 {
 		pageSize = conf.C.Sv.DefaultPageSize
@@ -97,7 +128,12 @@ func (i *postInteractor) CreatePost(ctx context.Context, p *models.Post, imageBu
 	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
 	defer cancel()
 
+	if err := fillApplyDeadline(p); err != nil {
+		return "", err
+	}
+
 	now := time.Now()
+	p.Status = models.PostStatusPending
 	p.CreatedAt = now
 	p.UpdatedAt = now
 
@@ -123,64 +159,55 @@ func (i *postInteractor) CreatePost(ctx context.Context, p *models.Post, imageBu
 		return "", err
 	}
 
+	s, err := i.beginCreatePostSaga(ctx, p)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return "", err
+	}
+
 	ctx, err = i.transactionRepo.Commit(ctx)
 	if err != nil {
 		return "", err
 	}
 
 	if len(imageBufs) != 0 {
-		if err := i.imageRepo.BatchCreateImages(ctx, p.ID, imageBufs); err != nil {
-			if err := i.postRepo.DeletePost(ctx, p.ID); err != nil {
-				return "", err
+		if err := s.Fire(ctx, "UploadImage", &saga.StepInput{Data: imageBufs}); err != nil {
+			// 補償に失敗しても、止まったサガとしてあとで補償される
+			if err := s.Compensate(ctx, err.Error()); err != nil {
+				log.Printf("error failed compensate saga id=%s: %s", s.ID, err)
 			}
 			return "", err
 		}
 	}
 
-	sagaID := uuid.New().String()
-	pProto, err := convPostProto(p)
-	if err != nil {
-		return "", err
-	}
-
-	state, err := saga.NewCreatePostSagaState(pProto, "init", sagaID)
-	if err != nil {
-		return "", err
-	}
-
-	s := i.createPostSagaManager.NewCreatePostSagaManager(state)
-
 	// 非同期
-	if err := s.FSM.Event("CreateRoom", ctx); err != nil {
+	if err := s.Fire(ctx, "CreateRoom", &saga.StepInput{}); err != nil {
 		return "", err
 	}
 
-	return sagaID, nil
+	return s.ID, nil
 }
 
-// imageBufsは新しいイメージ、deleteImageIDsは消すimageのID
-func (i *postInteractor) UpdatePost(ctx context.Context, p *models.Post, imageBufs []*bytes.Buffer, dltImageIDs []int64) error {
+// 下書きとして保存する。サガは始めず、イベントも発行しない
+func (i *postInteractor) CreateDraftPost(ctx context.Context, p *models.Post, imageBufs []*bytes.Buffer) error {
 	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
 	defer cancel()
-	fmt.Println(dltImageIDs)
-
-	now := time.Now()
 
-	oldP, err := i.postRepo.GetPostByID(ctx, p.ID)
-	if err != nil {
+	if err := fillApplyDeadline(p); err != nil {
 		return err
 	}
 
-	// 	// 完全なデータにする
-	p.UserID = oldP.UserID
-	p.CreatedAt = oldP.CreatedAt
+	now := time.Now()
+	p.Status = models.PostStatusDraft
+	p.CreatedAt = now
 	p.UpdatedAt = now
+
 	for _, f := range p.PostsFishTypes {
 		f.CreatedAt = now
 		f.UpdatedAt = now
 	}
 
-	ctx, err = i.transactionRepo.BeginTx(ctx)
+	ctx, err := i.transactionRepo.BeginTx(ctx)
 	if err != nil {
 		return err
 	}
@@ -191,16 +218,7 @@ func (i *postInteractor) UpdatePost(ctx context.Context, p *models.Post, imageBu
 		}
 	}()
 
-	cnt, err := i.applyPostRepo.CountApplyPostsByPostID(ctx, p.ID)
-	if err != nil {
-		return err
-	}
-
-	if cnt > p.MaxApply {
-		return status.Errorf(codes.FailedPrecondition, "got max_apply is %d but already have %d apply", p.MaxApply, cnt)
-	}
-
-	if err := i.postRepo.UpdatePost(ctx, p); err != nil {
+	if err := i.postRepo.CreatePost(ctx, p); err != nil {
 		i.transactionRepo.Roolback(ctx)
 		return err
 	}
@@ -210,20 +228,14 @@ func (i *postInteractor) UpdatePost(ctx context.Context, p *models.Post, imageBu
 		return err
 	}
 
-	if len(dltImageIDs) != 0 {
-		if err := i.imageRepo.BatchDeleteImages(ctx, dltImageIDs); err != nil {
-			if err := i.postRepo.UpdatePost(ctx, oldP); err != nil {
-				return err
-			}
-			return err
-		}
-
-	}
-
 	if len(imageBufs) != 0 {
 		if err := i.imageRepo.BatchCreateImages(ctx, p.ID, imageBufs); err != nil {
-			if err := i.postRepo.UpdatePost(ctx, oldP); err != nil {
-				return err
+			// 途中までアップロードされた画像ごと下書きを消す
+			if err := i.imageRepo.DeleteImagesByPostID(ctx, p.ID); err != nil {
+				log.Printf("error failed delete images of draft post id=%d: %s", p.ID, err)
+			}
+			if err := i.postRepo.DeletePost(ctx, p.ID); err != nil {
+				log.Printf("error failed delete draft post id=%d: %s", p.ID, err)
 			}
 			return err
 		}
@@ -232,23 +244,14 @@ func (i *postInteractor) UpdatePost(ctx context.Context, p *models.Post, imageBu
 	return nil
 }
 
-func (i *postInteractor) DeletePost(ctx context.Context, id int64) error {
+// 下書きを検証してpendingにし、CreatePostと同じサガを始める。画像は下書きのときにアップロード済み
+func (i *postInteractor) PublishPost(ctx context.Context, id int64, userID int64) (*models.Post, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
 	defer cancel()
 
-	p, err := i.postRepo.GetPostByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	event, err := newPostDeletedEvent(p)
-	if err != nil {
-		return err
-	}
-
-	ctx, err = i.transactionRepo.BeginTx(ctx)
+	ctx, err := i.transactionRepo.BeginTx(ctx)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
 	defer func() {
@@ -257,63 +260,91 @@ func (i *postInteractor) DeletePost(ctx context.Context, id int64) error {
 		}
 	}()
 
-	if err := i.postRepo.DeletePost(ctx, id); err != nil {
+	p, err := i.postRepo.GetPostByID(ctx, id)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, "", err
+	}
+
+	if p.UserID != userID {
 		i.transactionRepo.Roolback(ctx)
-		return err
+		return nil, "", status.Errorf(codes.PermissionDenied, "user with id='%d' is not the owner of post with id='%d'", userID, id)
 	}
 
-	if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+	if p.Status != models.PostStatusDraft {
 		i.transactionRepo.Roolback(ctx)
-		return err
+		return nil, "", status.Errorf(codes.FailedPrecondition, "post with id='%d' is %s, not draft", id, p.Status)
+	}
+
+	// 下書きを保存してから時間が経っている場合がある
+	now := time.Now()
+	if !now.Before(p.MeetingAt) || !now.Before(p.ApplyDeadline) {
+		i.transactionRepo.Roolback(ctx)
+		return nil, "", status.Errorf(codes.FailedPrecondition, "meeting_at or apply_deadline of post with id='%d' has passed", id)
+	}
+
+	p.Status = models.PostStatusPending
+	p.UpdatedAt = now
+
+	if err := i.postRepo.UpdatePostStatus(ctx, id, p.Status, p.UpdatedAt); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, "", err
+	}
+
+	s, err := i.beginCreatePostSaga(ctx, p)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, "", err
 	}
 
 	ctx, err = i.transactionRepo.Commit(ctx)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
-	if err := i.imageRepo.DeleteImagesByPostID(ctx, id); err != nil {
-		return err
+	// 非同期
+	if err := s.Fire(ctx, "CreateRoom", &saga.StepInput{}); err != nil {
+		return nil, "", err
 	}
 
-	return nil
+	return p, s.ID, nil
 }
 
-func (i *postInteractor) GetApplyPost(ctx context.Context, id int64) (*models.ApplyPost, error) {
-	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
-	defer cancel()
-	return i.applyPostRepo.GetApplyPostByID(ctx, id)
-}
+// CreatePostSagaのインスタンスを作る。post.createdはサガが投稿を公開するときに発行する
+// 投稿とサガが片方だけ残らないよう、投稿の作成や公開と同じトランザクションのctxで呼ぶ
+func (i *postInteractor) beginCreatePostSaga(ctx context.Context, p *models.Post) (*saga.Saga, error) {
+	pProto, err := convPostProto(p)
+	if err != nil {
+		return nil, err
+	}
 
-func (i *postInteractor) ListApplyPosts(ctx context.Context, a *models.ApplyPost) ([]*models.ApplyPost, error) {
-	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
-	defer cancel()
-	if a.UserID != 0 {
-		return i.applyPostRepo.ListApplyPostsByUserID(ctx, a.UserID)
+	s, err := i.sagaOrchestrator.New(saga.CreatePostSagaType, uuid.New().String(), pProto)
+	if err != nil {
+		return nil, err
 	}
-	if a.PostID != 0 {
-		return i.applyPostRepo.ListApplyPostsByPostID(ctx, a.PostID)
+
+	if err := s.Begin(ctx); err != nil {
+		return nil, err
 	}
-	return nil, nil
-}
 
-func (i *postInteractor) BatchGetApplyPostsByPostIDs(ctx context.Context, postIDs []int64) ([]*models.ApplyPost, error) {
-	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
-	defer cancel()
-	return i.applyPostRepo.BatchGetApplyPostsByPostIDs(ctx, postIDs)
+	return s, nil
 }
 
-func (i *postInteractor) CreateApplyPost(ctx context.Context, a *models.ApplyPost) error {
+// imageBufsは新しいイメージ、deleteImageIDsは消すimageのID
+func (i *postInteractor) UpdatePost(ctx context.Context, p *models.Post, imageBufs []*bytes.Buffer, dltImageIDs []int64) error {
 	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
 	defer cancel()
+	fmt.Println(dltImageIDs)
 
 	now := time.Now()
-	a.CreatedAt = now
-	a.UpdatedAt = now
+
+	if err := fillApplyDeadline(p); err != nil {
+		return err
+	}
 
 	ctx, err := i.transactionRepo.BeginTx(ctx)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	defer func() {
@@ -322,61 +353,183 @@ func (i *postInteractor) CreateApplyPost(ctx context.Context, a *models.ApplyPos
 		}
 	}()
 
-	cnt, err := i.applyPostRepo.CountApplyPostsByPostID(ctx, a.PostID)
+	// 同時に更新された内容を上書きしたり、定員を変えている間に応募が承認されたりしないように、
+	// 投稿の行をロックして読んでから数える
+	oldP, err := i.postRepo.GetPostByIDForUpdate(ctx, p.ID)
 	if err != nil {
+		i.transactionRepo.Roolback(ctx)
 		return err
 	}
 
-	p, err := i.postRepo.GetPostByID(ctx, a.PostID)
+	if oldP.Status == models.PostStatusClosed {
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "post with id='%d' is closed", p.ID)
+	}
+
+	// 	// 完全なデータにする
+	p.UserID = oldP.UserID
+	p.Status = oldP.Status
+	p.CreatedAt = oldP.CreatedAt
+	p.UpdatedAt = now
+	for _, f := range p.PostsFishTypes {
+		f.CreatedAt = now
+		f.UpdatedAt = now
+	}
+
+	cnt, err := i.applyPostRepo.CountAcceptedSeatsByPostID(ctx, p.ID)
 	if err != nil {
+		i.transactionRepo.Roolback(ctx)
 		return err
 	}
 
-	if p.MaxApply <= cnt {
-		return status.Error(codes.FailedPrecondition, "already reached max_apply limit")
+	if cnt > p.MaxApply {
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "got max_apply is %d but already have %d apply", p.MaxApply, cnt)
 	}
 
-	if err := i.applyPostRepo.CreateApplyPost(ctx, a); err != nil {
+	if err := i.postRepo.UpdatePost(ctx, p); err != nil {
 		i.transactionRepo.Roolback(ctx)
 		return err
 	}
 
-	event, err := newApplyPostCreatedEvent(a)
+	// 定員を増やした人数分だけ空き待ちの応募を繰り上げる。キャンセルされた投稿などは開かれないので繰り上げない
+	if raised := p.MaxApply - oldP.MaxApply; raised > 0 && oldP.Status == models.PostStatusPublished {
+		seats := p.MaxApply - cnt
+		if raised < seats {
+			seats = raised
+		}
+		if err := saga.PromoteWaitlistedApplyPosts(ctx, i.applyPostRepo, i.outboxRepo, p.ID, seats, now); err != nil {
+			i.transactionRepo.Roolback(ctx)
+			return err
+		}
+	}
+
+	// 下書きは公開されていないので、イベントを発行しない
+	if oldP.Status != models.PostStatusDraft {
+		event, err := newPostUpdatedEvent(ctx, oldP, p)
+		if err != nil {
+			i.transactionRepo.Roolback(ctx)
+			return err
+		}
+
+		if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+			i.transactionRepo.Roolback(ctx)
+			return err
+		}
+	}
+
+	ctx, err = i.transactionRepo.Commit(ctx)
 	if err != nil {
-		i.transactionRepo.Roolback(ctx)
 		return err
 	}
 
-	if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+	if len(dltImageIDs) != 0 {
+		if err := i.imageRepo.BatchDeleteImages(ctx, dltImageIDs); err != nil {
+			if err := i.revertUpdatedPost(ctx, oldP, p); err != nil {
+				log.Printf("error failed revert post id=%d: %s", p.ID, err)
+			}
+			return err
+		}
+
+	}
+
+	if len(imageBufs) != 0 {
+		if err := i.imageRepo.BatchCreateImages(ctx, p.ID, imageBufs); err != nil {
+			if err := i.revertUpdatedPost(ctx, oldP, p); err != nil {
+				log.Printf("error failed revert post id=%d: %s", p.ID, err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 画像の更新に失敗したときに、投稿をoldPに戻す
+// 発行済みのpost.updatedを打ち消すpost.updatedを、戻すのと同じトランザクションで発行する
+func (i *postInteractor) revertUpdatedPost(ctx context.Context, oldP *models.Post, p *models.Post) error {
+	now := time.Now()
+
+	revertP := *oldP
+	revertP.UpdatedAt = now
+	for _, f := range revertP.PostsFishTypes {
+		f.CreatedAt = now
+		f.UpdatedAt = now
+	}
+
+	ctx, err := i.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if recover() != nil {
+			i.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	if _, err := i.postRepo.GetPostByIDForUpdate(ctx, p.ID); err != nil {
 		i.transactionRepo.Roolback(ctx)
 		return err
 	}
 
-	ctx, err = i.transactionRepo.Commit(ctx)
+	// 更新した後に承認された応募があれば、定員はそれを下回らないようにする
+	cnt, err := i.applyPostRepo.CountAcceptedSeatsByPostID(ctx, p.ID)
 	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if cnt > revertP.MaxApply {
+		revertP.MaxApply = p.MaxApply
+	}
+
+	if err := i.postRepo.UpdatePost(ctx, &revertP); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if oldP.Status != models.PostStatusDraft {
+		event, err := newPostUpdatedEvent(ctx, p, &revertP)
+		if err != nil {
+			i.transactionRepo.Roolback(ctx)
+			return err
+		}
+
+		if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+			i.transactionRepo.Roolback(ctx)
+			return err
+		}
+	}
+
+	if _, err := i.transactionRepo.Commit(ctx); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (i *postInteractor) DeleteApplyPost(ctx context.Context, id int64) error {
+// チャットルームを消すサガを始めて、サガIDを返す。投稿はルームが消えてから消える
+func (i *postInteractor) DeletePost(ctx context.Context, id int64) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
 	defer cancel()
 
-	a, err := i.applyPostRepo.GetApplyPostByID(ctx, id)
+	p, err := i.postRepo.GetPostByID(ctx, id)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	event, err := newApplyPostDeletedEvent(a)
-	if err != nil {
-		return err
+	// 下書きと作成を断られた投稿にはチャットルームが無いので、サガを使わずにすぐ消す
+	if p.Status == models.PostStatusDraft || p.Status == models.PostStatusRejected {
+		if err := i.imageRepo.DeleteImagesByPostID(ctx, id); err != nil {
+			return "", err
+		}
+		return "", i.postRepo.DeletePost(ctx, id)
 	}
 
 	ctx, err = i.transactionRepo.BeginTx(ctx)
 	if err != nil {
-		return nil
+		return "", err
 	}
 
 	defer func() {
@@ -385,20 +538,584 @@ func (i *postInteractor) DeleteApplyPost(ctx context.Context, id int64) error {
 		}
 	}()
 
-	if err := i.applyPostRepo.DeleteApplyPost(ctx, a.ID); err != nil {
+	// 同じ投稿を消すサガが同時に始まらないように、投稿の行をロックしてから確かめる
+	p, err = i.postRepo.GetPostByIDForUpdate(ctx, id)
+	if err != nil {
 		i.transactionRepo.Roolback(ctx)
-		return err
+		return "", err
 	}
 
-	if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+	// 作成のサガが終わるまではチャットルームがあるかが決まっていないので、消せない
+	if p.Status == models.PostStatusPending {
 		i.transactionRepo.Roolback(ctx)
-		return err
+		return "", status.Errorf(codes.FailedPrecondition, "post with id='%d' is still being created", p.ID)
 	}
 
-	ctx, err = i.transactionRepo.Commit(ctx)
+	exists, err := i.sagaOrchestrator.ExistsUnfinished(ctx, saga.DeletePostSagaType, p.ID)
 	if err != nil {
-		return err
+		i.transactionRepo.Roolback(ctx)
+		return "", err
+	}
+
+	if exists {
+		i.transactionRepo.Roolback(ctx)
+		return "", status.Errorf(codes.FailedPrecondition, "post with id='%d' is already being deleted", p.ID)
 	}
 
+	pProto, err := convPostProto(p)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return "", err
+	}
+
+	s, err := i.sagaOrchestrator.New(saga.DeletePostSagaType, uuid.New().String(), pProto)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return "", err
+	}
+
+	if err := s.Begin(ctx); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return "", err
+	}
+
+	ctx, err = i.transactionRepo.Commit(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// 非同期
+	if err := s.Fire(ctx, "DeleteRoom", &saga.StepInput{}); err != nil {
+		// 補償に失敗しても、止まったサガとしてあとで補償される
+		if err := s.Compensate(ctx, err.Error()); err != nil {
+			log.Printf("error failed compensate saga id=%s: %s", s.ID, err)
+		}
+		return "", err
+	}
+
+	return s.ID, nil
+}
+
+// 投稿と応募は残したままキャンセル済みにして、応募者に通知するイベントを発行する
+func (i *postInteractor) CancelPost(ctx context.Context, id int64, userID int64, reason string) (*models.Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	ctx, err := i.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if recover() != nil {
+			i.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	// キャンセルする間に応募が承認されたり取り消されたりして、通知する応募者が変わらないように投稿の行をロックする
+	p, err := i.postRepo.GetPostByIDForUpdate(ctx, id)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	if p.UserID != userID {
+		i.transactionRepo.Roolback(ctx)
+		return nil, status.Errorf(codes.PermissionDenied, "user with id='%d' is not the owner of post with id='%d'", userID, id)
+	}
+
+	if p.Status != models.PostStatusPublished && p.Status != models.PostStatusClosed {
+		i.transactionRepo.Roolback(ctx)
+		return nil, status.Errorf(codes.FailedPrecondition, "post with id='%d' is %s and cannot be cancelled", id, p.Status)
+	}
+
+	list, err := i.applyPostRepo.ListApplyPostsByPostID(ctx, id)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	// 断られた応募や取り消された応募の応募者には知らせない
+	applicantUserIDs := []int64{}
+	for _, a := range list {
+		switch a.Status {
+		case models.ApplyPostStatusPending, models.ApplyPostStatusAccepted, models.ApplyPostStatusWaitlisted:
+			applicantUserIDs = append(applicantUserIDs, a.UserID)
+		}
+	}
+
+	p.Status = models.PostStatusCancelled
+	p.CancelReason = reason
+	p.UpdatedAt = time.Now()
+
+	if err := i.postRepo.CancelPost(ctx, id, reason, p.UpdatedAt); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	event, err := newPostCancelledEvent(ctx, p, applicantUserIDs)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	if _, err := i.transactionRepo.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (i *postInteractor) GetApplyPost(ctx context.Context, id int64) (*models.ApplyPost, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+	return i.applyPostRepo.GetApplyPostByID(ctx, id)
+}
+
+func (i *postInteractor) ListApplyPosts(ctx context.Context, a *models.ApplyPost) ([]*models.ApplyPost, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+	if a.UserID != 0 {
+		return i.applyPostRepo.ListApplyPostsByUserID(ctx, a.UserID)
+	}
+	if a.PostID != 0 {
+		return i.applyPostRepo.ListApplyPostsByPostID(ctx, a.PostID)
+	}
+	return nil, nil
+}
+
+func (i *postInteractor) BatchGetApplyPostsByPostIDs(ctx context.Context, postIDs []int64) ([]*models.ApplyPost, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+	return i.applyPostRepo.BatchGetApplyPostsByPostIDs(ctx, postIDs)
+}
+
+// 応募はpendingで作り、投稿者が承認するまでmax_applyには数えない
+// 定員に達している場合は空き待ちにする
+func (i *postInteractor) CreateApplyPost(ctx context.Context, a *models.ApplyPost) error {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	now := time.Now()
+	if a.PartySize == 0 {
+		a.PartySize = 1
+	}
+	a.Status = models.ApplyPostStatusPending
+	a.CreatedAt = now
+	a.UpdatedAt = now
+
+	ctx, err := i.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if recover() != nil {
+			i.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	// 先に投稿の行をロックして、同じ投稿への応募を1つずつ処理する
+	p, err := i.postRepo.GetPostByIDForUpdate(ctx, a.PostID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	cnt, err := i.applyPostRepo.CountAcceptedSeatsByPostID(ctx, a.PostID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if p.Status != models.PostStatusPublished {
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "post with id='%d' is not published", p.ID)
+	}
+
+	if !now.Before(p.ApplyDeadline) {
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "apply deadline of post with id='%d' has passed", p.ID)
+	}
+
+	if p.MaxApply < a.PartySize {
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "party_size %d is larger than max_apply %d", a.PartySize, p.MaxApply)
+	}
+
+	// 空き待ちの応募がある間は、空いた枠に入れる人数でも後から来た応募を先に入れない
+	waitlisted, err := i.applyPostRepo.ListWaitlistedApplyPostsByPostID(ctx, a.PostID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if p.MaxApply < cnt+a.PartySize || len(waitlisted) != 0 {
+		a.Status = models.ApplyPostStatusWaitlisted
+	}
+
+	old, err := i.applyPostRepo.GetApplyPostByPostIDAndUserID(ctx, a.PostID, a.UserID)
+	switch {
+	case err == nil && old.Status == models.ApplyPostStatusWithdrawn:
+		// 取り消した応募は同じ行を使って応募し直す
+		a.ID = old.ID
+		a.CreatedAt = old.CreatedAt
+		if err := i.applyPostRepo.UpdateApplyPost(ctx, a); err != nil {
+			i.transactionRepo.Roolback(ctx)
+			return err
+		}
+	case err == nil:
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.AlreadyExists, "user with id='%d' already applied to post with id='%d'", a.UserID, a.PostID)
+	case status.Code(err) != codes.NotFound:
+		i.transactionRepo.Roolback(ctx)
+		return err
+	default:
+		if err := i.applyPostRepo.CreateApplyPost(ctx, a); err != nil {
+			i.transactionRepo.Roolback(ctx)
+			return err
+		}
+	}
+
+	event, err := newApplyPostCreatedEvent(ctx, a)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if _, err := i.transactionRepo.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// 投稿者が応募を承認する。応募者を投稿のチャットルームに追加できたらacceptedにする
+func (i *postInteractor) AcceptApplyPost(ctx context.Context, id int64, userID int64) (*models.ApplyPost, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	// ロックする投稿を知るために、トランザクションの外で読む
+	a, err := i.applyPostRepo.GetApplyPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = i.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if recover() != nil {
+			i.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	a, p, err := i.getApplyPostForOwner(ctx, a.PostID, id, userID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	if p.Status != models.PostStatusPublished {
+		i.transactionRepo.Roolback(ctx)
+		return nil, status.Errorf(codes.FailedPrecondition, "post with id='%d' is not published", p.ID)
+	}
+
+	cnt, err := i.applyPostRepo.CountAcceptedSeatsByPostID(ctx, p.ID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	if p.MaxApply < cnt+a.PartySize {
+		i.transactionRepo.Roolback(ctx)
+		return nil, status.Errorf(codes.FailedPrecondition, "party_size %d exceeds remaining seats %d", a.PartySize, p.MaxApply-cnt)
+	}
+
+	// チャットルームに追加する前に枠を押さえる。追加できなければRejectApplyでpendingに戻す
+	if err := i.applyPostRepo.UpdateApplyPostStatus(ctx, a.ID, models.ApplyPostStatusAccepted, time.Now()); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	aProto, err := convApplyPostProto(a)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	s, err := i.sagaOrchestrator.New(saga.ApplyPostSagaType, uuid.New().String(), aProto)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	// 承認の確認とサガインスタンスを同じトランザクションで作る
+	if err := s.Begin(ctx); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	ctx, err = i.transactionRepo.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Fire(ctx, "AddMember", &saga.StepInput{}); err != nil {
+		// 補償に失敗しても、止まったサガとしてあとで補償される
+		if err := s.Compensate(ctx, err.Error()); err != nil {
+			log.Printf("error failed compensate saga id=%s: %s", s.ID, err)
+		}
+		return nil, err
+	}
+
+	if err := s.Fire(ctx, "ApproveApply", &saga.StepInput{}); err != nil {
+		// 承認できなかった応募者はチャットルームから外す
+		if err := s.Compensate(ctx, err.Error()); err != nil {
+			log.Printf("error failed compensate saga id=%s: %s", s.ID, err)
+		}
+		return nil, err
+	}
+
+	return i.applyPostRepo.GetApplyPostByID(ctx, a.ID)
+}
+
+// 投稿者が応募を断る。チャットルームにはまだ追加していないのでサガは使わない
+func (i *postInteractor) DeclineApplyPost(ctx context.Context, id int64, userID int64) (*models.ApplyPost, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	// ロックする投稿を知るために、トランザクションの外で読む
+	a, err := i.applyPostRepo.GetApplyPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = i.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if recover() != nil {
+			i.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	a, _, err = i.getApplyPostForOwner(ctx, a.PostID, id, userID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	a.Status = models.ApplyPostStatusDeclined
+	a.UpdatedAt = time.Now()
+
+	if err := i.applyPostRepo.UpdateApplyPostStatus(ctx, a.ID, a.Status, a.UpdatedAt); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	event, err := newApplyPostDeclinedEvent(ctx, a)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	if err := i.outboxRepo.CreateOutbox(ctx, event); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return nil, err
+	}
+
+	if _, err := i.transactionRepo.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// 投稿者だけが、まだ決めていない(pending)応募を承認したり断ったりできる
+// 投稿と応募の行をロックして最新を読むので、トランザクションの最初に呼ぶ
+func (i *postInteractor) getApplyPostForOwner(ctx context.Context, postID int64, id int64, userID int64) (*models.ApplyPost, *models.Post, error) {
+	p, err := i.postRepo.GetPostByIDForUpdate(ctx, postID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a, err := i.applyPostRepo.GetApplyPostByIDForUpdate(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if p.UserID != userID {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "user with id='%d' is not the owner of post with id='%d'", userID, p.ID)
+	}
+
+	if a.Status != models.ApplyPostStatusPending {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "apply post with id='%d' is %s", a.ID, a.Status)
+	}
+
+	return a, p, nil
+}
+
+// 応募を取り消す。承認済みの応募は、応募者をチャットルームから外せたら取り消し済みにする
+func (i *postInteractor) DeleteApplyPost(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	// ロックする投稿を知るために、トランザクションの外で読む
+	a, err := i.applyPostRepo.GetApplyPostByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = i.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if recover() != nil {
+			i.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	// 状態を確かめてから変えるまでに承認や他の取り消しが割り込まないように、投稿と応募の行をロックして読み直す
+	p, err := i.postRepo.GetPostByIDForUpdate(ctx, a.PostID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	a, err = i.applyPostRepo.GetApplyPostByIDForUpdate(ctx, id)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	// 締め切り後に取り消されると、投稿者が人数を調整できない
+	if !time.Now().Before(p.ApplyDeadline) {
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "apply deadline of post with id='%d' has passed", p.ID)
+	}
+
+	// 承認のサガが終わるまでは、承認済みにするかpendingに戻すかが決まっていない
+	approving, err := i.sagaOrchestrator.ExistsUnfinished(ctx, saga.ApplyPostSagaType, a.ID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if approving {
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "apply post with id='%d' is being accepted", a.ID)
+	}
+
+	switch a.Status {
+	case models.ApplyPostStatusPending, models.ApplyPostStatusWaitlisted:
+		if err := i.withdrawPendingApplyPost(ctx, a); err != nil {
+			i.transactionRepo.Roolback(ctx)
+			return err
+		}
+		_, err := i.transactionRepo.Commit(ctx)
+		return err
+	case models.ApplyPostStatusAccepted:
+	default:
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "apply post with id='%d' is %s and cannot be withdrawn", a.ID, a.Status)
+	}
+
+	// 承認済みの応募を取り消すサガは、応募ごとに1つだけ進める
+	exists, err := i.sagaOrchestrator.ExistsUnfinished(ctx, saga.DeleteApplyPostSagaType, a.ID)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if exists {
+		i.transactionRepo.Roolback(ctx)
+		return status.Errorf(codes.FailedPrecondition, "apply post with id='%d' is already being withdrawn", a.ID)
+	}
+
+	aProto, err := convApplyPostProto(a)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	s, err := i.sagaOrchestrator.New(saga.DeleteApplyPostSagaType, uuid.New().String(), aProto)
+	if err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if err := s.Begin(ctx); err != nil {
+		i.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	ctx, err = i.transactionRepo.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Fire(ctx, "RemoveMember", &saga.StepInput{}); err != nil {
+		// initのままでもRemovingMemberに進んでいても補償できる。補償に失敗しても、止まったサガとしてあとで補償される
+		if err := s.Compensate(ctx, err.Error()); err != nil {
+			log.Printf("error failed compensate saga id=%s: %s", s.ID, err)
+		}
+		return err
+	}
+
+	if err := s.Fire(ctx, "DeleteApply", &saga.StepInput{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// pendingと空き待ちの応募はチャットルームに追加していないので、そのまま取り消し済みにする
+// 投稿と応募の行をロックしたトランザクションのctxで呼ぶ
+func (i *postInteractor) withdrawPendingApplyPost(ctx context.Context, a *models.ApplyPost) error {
+	a.Status = models.ApplyPostStatusWithdrawn
+	a.UpdatedAt = time.Now()
+
+	if err := i.applyPostRepo.UpdateApplyPostStatus(ctx, a.ID, a.Status, a.UpdatedAt); err != nil {
+		return err
+	}
+
+	aProto, err := convApplyPostProto(a)
+	if err != nil {
+		return err
+	}
+
+	event, err := saga.NewApplyPostWithdrawnEvent(ctx, aProto)
+	if err != nil {
+		return err
+	}
+
+	return i.outboxRepo.CreateOutbox(ctx, event)
+}
+
+// 応募の締め切りが空の場合はmeeting_atにする。meeting_atより後にはできない
+func fillApplyDeadline(p *models.Post) error {
+	if p.ApplyDeadline.IsZero() {
+		p.ApplyDeadline = p.MeetingAt
+	}
+	if p.ApplyDeadline.After(p.MeetingAt) {
+		return status.Error(codes.InvalidArgument, "apply_deadline must be before or equal to meeting_at")
+	}
 	return nil
 }
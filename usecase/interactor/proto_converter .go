@@ -1,6 +1,7 @@
 package interactor
 
 import (
+	"strings"
 	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
@@ -21,6 +22,10 @@ func convPostProto(p *models.Post) (*pb.Post, error) {
 	if err != nil {
 		return nil, err
 	}
+	dAt, err := ptypes.TimestampProto(p.ApplyDeadline)
+	if err != nil {
+		return nil, err
+	}
 
 	return &pb.Post{
 		Id:                p.ID,
@@ -31,14 +36,26 @@ func convPostProto(p *models.Post) (*pb.Post, error) {
 		PrefectureId:      p.PrefectureID,
 		MeetingPlaceId:    p.MeetingPlaceID,
 		MeetingAt:         mAt,
+		ApplyDeadline:     dAt,
 		MaxApply:          p.MaxApply,
 		UserId:            p.UserID,
+		Status:            convPostStatusProto(p.Status),
+		CancelReason:      p.CancelReason,
 		CreatedAt:         cAt,
 		UpdatedAt:         uAt,
 	}, nil
 
 }
 
+// ステータスの文字列をprotoのenumにする。enumの名前はステータスを大文字にしたもの
+func convPostStatusProto(s string) pb.Post_Status {
+	return pb.Post_Status(pb.Post_Status_value[strings.ToUpper(s)])
+}
+
+func convApplyPostStatusProto(s string) pb.ApplyPost_Status {
+	return pb.ApplyPost_Status(pb.ApplyPost_Status_value[strings.ToUpper(s)])
+}
+
 func convListPostsProto(list []*models.Post) ([]*pb.Post, error) {
 	listP := make([]*pb.Post, len(list))
 	for i, p := range list {
@@ -64,6 +81,9 @@ func convApplyPostProto(a *models.ApplyPost) (*pb.ApplyPost, error) {
 		Id:        a.ID,
 		PostId:    a.PostID,
 		UserId:    a.UserID,
+		Status:    convApplyPostStatusProto(a.Status),
+		PartySize: a.PartySize,
+		Note:      a.Note,
 		CreatedAt: cAt,
 		UpdatedAt: uAt,
 	}, nil
@@ -114,3 +134,34 @@ func convPostFilter(f *pb.ListPostsReq_Filter) (*models.PostFilter, error) {
 	}
 	return postF, nil
 }
+
+func convEventProto(o *models.Outbox) (*pb.Event, error) {
+	cAt, err := ptypes.TimestampProto(o.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	uAt, err := ptypes.TimestampProto(o.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	oAt, err := ptypes.TimestampProto(o.OccurredAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Event{
+		Id:            o.ID,
+		EventType:     o.EventType,
+		AggregateId:   o.AggregateID,
+		AggregateType: o.AggregateType,
+		EventData:     o.EventData,
+		Channel:       o.Channel,
+		SchemaVersion: o.SchemaVersion,
+		CorrelationId: o.CorrelationID,
+		CausationId:   o.CausationID,
+		Producer:      o.Producer,
+		UserId:        o.UserID,
+		OccurredAt:    oAt,
+		CreatedAt:     cAt,
+		UpdatedAt:     uAt,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package saga
+
+import (
+	"context"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/pb"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const ApplyPostSagaType = "ApplyPostSaga"
+
+// 途中で落ちると止まったままになるステート
+var ApplyPostSagaPendingStates = []string{"init", "AddingMember"}
+
+// これ以上ステートが変わらないステート
+var ApplyPostSagaFinishedStates = []string{"ApplyApproved", "ApplyRejected"}
+
+// ApplyPostSagaの定義。saga_dataには応募を入れる
+// 応募者を投稿のチャットルームに追加できたら応募を承認する。追加できなければ応募をpendingに戻す
+// メンバーの追加はリクエストの中で同期的にやるので、止まっている場合は再試行せずに補償する
+func NewApplyPostSagaDefinition(ar repo.ApplyPostRepo, cr repo.ChatRepo) *Definition {
+	return &Definition{
+		SagaType: ApplyPostSagaType,
+		NewData:  func() proto.Message { return &pb.ApplyPost{} },
+		Steps: []*Step{
+			{
+				Name: "AddMember", Src: []string{"init"}, Dst: "AddingMember",
+				// メンバー追加の途中で落ちても補償できるように、先にステートを進めておく
+				After: func(ctx context.Context, s *Saga, in *StepInput) error {
+					return cr.CreateMember(ctx, sagaApplyPost(s).PostId, sagaApplyPost(s).UserId)
+				},
+			},
+			{
+				Name: "RejectApply", Src: []string{"init", "AddingMember"}, Dst: "ApplyRejected",
+				// 追加できていた場合に備えてメンバーから外す。失敗した場合はステートを変えずに返して、あとでやり直す
+				Before: func(ctx context.Context, s *Saga, in *StepInput) error {
+					return cr.DeleteMember(ctx, sagaApplyPost(s).PostId, sagaApplyPost(s).UserId)
+				},
+				// 承認時に押さえた枠を返して応募をpendingに戻す。投稿者はもう一度承認できる
+				// その間に取り消された応募は、取り消し済みのまま残す
+				Local: func(ctx context.Context, s *Saga, in *StepInput) error {
+					a, err := ar.GetApplyPostByIDForUpdate(ctx, sagaApplyPost(s).Id)
+					if err != nil {
+						return err
+					}
+					if a.Status != models.ApplyPostStatusAccepted {
+						return nil
+					}
+					return ar.UpdateApplyPostStatus(ctx, a.ID, models.ApplyPostStatusPending, time.Now())
+				},
+				Compensation: true,
+			},
+			{
+				Name: "ApproveApply", Src: []string{"AddingMember"}, Dst: "ApplyApproved",
+				// 枠はAcceptApplyPostで押さえてある。メンバーに追加するまでに取り消された場合は承認せずに補償する
+				Local: func(ctx context.Context, s *Saga, in *StepInput) error {
+					a, err := ar.GetApplyPostByIDForUpdate(ctx, sagaApplyPost(s).Id)
+					if err != nil {
+						return err
+					}
+					if a.Status != models.ApplyPostStatusAccepted {
+						return status.Errorf(codes.FailedPrecondition, "apply post with id='%d' is %s", a.ID, a.Status)
+					}
+					return nil
+				},
+				// メンバーに追加できてから応募の承認を知らせる
+				Command: func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error) {
+					return newApplyPostAcceptedEvent(ctx, sagaApplyPostWithStatus(s, pb.ApplyPost_ACCEPTED))
+				},
+			},
+		},
+		Compensation:   "RejectApply",
+		PendingStates:  ApplyPostSagaPendingStates,
+		FinishedStates: ApplyPostSagaFinishedStates,
+	}
+}
+
+func sagaApplyPost(s *Saga) *pb.ApplyPost {
+	return s.Data.(*pb.ApplyPost)
+}
+
+// イベントにはステップで変えた後のステータスを入れる。saga_dataの応募は作成時のまま残す
+func sagaApplyPostWithStatus(s *Saga, st pb.ApplyPost_Status) *pb.ApplyPost {
+	a := proto.Clone(sagaApplyPost(s)).(*pb.ApplyPost)
+	a.Status = st
+	return a
+}
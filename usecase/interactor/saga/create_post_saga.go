@@ -1,6 +1,7 @@
 package saga
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"time"
@@ -8,279 +9,110 @@ import (
 	"github.com/ezio1119/fishapp-post/models"
 	"github.com/ezio1119/fishapp-post/pb"
 	"github.com/ezio1119/fishapp-post/usecase/repo"
-	"github.com/looplab/fsm"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
-type createPostSagaState struct {
-	sagaID       string
-	sagaType     string
-	currentState string
-	post         *pb.Post
-	createdAt    time.Time
-	updatedAt    time.Time
-}
-
-func NewCreatePostSagaState(p *pb.Post, state, sagaID string) (*createPostSagaState, error) {
-	now := time.Now()
-	return &createPostSagaState{
-		sagaType:     "CreatePostSaga",
-		post:         p,
-		sagaID:       sagaID,
-		currentState: state,
-		createdAt:    now,
-		updatedAt:    now,
-	}, nil
-}
-
-func (s *createPostSagaState) convSagaInstance(state string) (*models.SagaInstance, error) {
-	jsonPost, err := protojson.Marshal(s.post)
-	if err != nil {
-		return nil, err
-	}
-	return &models.SagaInstance{
-		ID:           s.sagaID,
-		SagaType:     s.sagaType,
-		SagaData:     jsonPost,
-		CurrentState: state,
-		CreatedAt:    s.createdAt,
-		UpdatedAt:    s.updatedAt,
-	}, nil
-}
-
-type CreatePostSagaManager struct {
-	FSM              *fsm.FSM
-	state            *createPostSagaState
-	outboxRepo       repo.OutboxRepo
-	postRepo         repo.PostRepo
-	sagaInstanceRepo repo.SagaInstanceRepo
-	transactionRepo  repo.TransactionRepo
-}
-
-func InitCreatePostSagaManager(
-	or repo.OutboxRepo,
-	pr repo.PostRepo,
-	sr repo.SagaInstanceRepo,
-	tr repo.TransactionRepo,
-) *CreatePostSagaManager {
-	return &CreatePostSagaManager{
-		outboxRepo:       or,
-		postRepo:         pr,
-		sagaInstanceRepo: sr,
-		transactionRepo:  tr,
-	}
-}
-
-func (m *CreatePostSagaManager) NewCreatePostSagaManager(state *createPostSagaState) *CreatePostSagaManager {
-
-	m.state = state
-
-	m.FSM = fsm.NewFSM(
-		"init",
-		fsm.Events{
-			// {Name: "UploadImage", Src: []string{"Init"}, Dst: "UploadingImage"},
-			{Name: "CreateRoom", Src: []string{"init"}, Dst: "CreatingRoom"},
-			{Name: "RejectPost", Src: []string{"CreatingRoom"}, Dst: "PostRejected"},
-			{Name: "ApprovePost", Src: []string{"CreatingRoom"}, Dst: "PostApproved"},
+const CreatePostSagaType = "CreatePostSaga"
+
+// 途中で落ちたりリプライが返ってこなかったりすると、止まったままになるステート
+var CreatePostSagaPendingStates = []string{"init", "UploadingImage", "CreatingRoom"}
+
+// これ以上ステートが変わらないステート
+var CreatePostSagaFinishedStates = []string{"PostApproved", "PostRejected"}
+
+// CreatePostSagaの定義。saga_dataには投稿を入れる
+func NewCreatePostSagaDefinition(pr repo.PostRepo, ir repo.ImageRepo, or repo.OutboxRepo) *Definition {
+	return &Definition{
+		SagaType: CreatePostSagaType,
+		NewData:  func() proto.Message { return &pb.Post{} },
+		Steps: []*Step{
+			{
+				Name: "UploadImage", Src: []string{"init"}, Dst: "UploadingImage",
+				// アップロードの途中で落ちても補償できるように、先にステートを進めておく
+				After: func(ctx context.Context, s *Saga, in *StepInput) error {
+					imageBufs, ok := in.Data.([]*bytes.Buffer)
+					if !ok {
+						return errors.New("missing image buffers")
+					}
+					return ir.BatchCreateImages(ctx, sagaPost(s).Id, imageBufs)
+				},
+			},
+			{
+				Name: "CreateRoom", Src: []string{"init", "UploadingImage"}, Dst: "CreatingRoom",
+				Command: func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error) {
+					return newCreateRoomEvent(ctx, &pb.CreateRoom{
+						SagaId: s.ID,
+						PostId: sagaPost(s).Id,
+						UserId: sagaPost(s).UserId,
+					})
+				},
+				Retryable: true,
+			},
+			{
+				Name: "RejectPost", Src: []string{"init", "UploadingImage", "CreatingRoom"}, Dst: "PostRejected",
+				// アップロード済みの画像を消す。失敗した場合はステートを変えずに返して、あとでやり直す
+				Before: func(ctx context.Context, s *Saga, in *StepInput) error {
+					return ir.DeleteImagesByPostID(ctx, sagaPost(s).Id)
+				},
+				// 投稿は消さずにrejectedとして残す
+				Local: func(ctx context.Context, s *Saga, in *StepInput) error {
+					return pr.UpdatePostStatus(ctx, sagaPost(s).Id, models.PostStatusRejected, time.Now())
+				},
+				Command: func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error) {
+					return newPostRejectedEvent(ctx, sagaPostWithStatus(s, pb.Post_REJECTED), s.ID, in.Reason)
+				},
+				Compensation: true,
+			},
+			{
+				Name: "ApprovePost", Src: []string{"CreatingRoom"}, Dst: "PostApproved",
+				// 公開されてから他のサービスに投稿の作成を知らせる
+				Local: func(ctx context.Context, s *Saga, in *StepInput) error {
+					if err := pr.UpdatePostStatus(ctx, sagaPost(s).Id, models.PostStatusPublished, time.Now()); err != nil {
+						return err
+					}
+
+					event, err := newPostCreatedEvent(ctx, sagaPostWithStatus(s, pb.Post_PUBLISHED))
+					if err != nil {
+						return err
+					}
+
+					return or.CreateOutbox(ctx, event)
+				},
+				Command: func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error) {
+					return newPostApprovedEvent(ctx, sagaPostWithStatus(s, pb.Post_PUBLISHED), s.ID)
+				},
+			},
 		},
-		fsm.Callbacks{
-			// "UploadImage": func(e *fsm.Event) { s.uploadImage(e) },
-			"CreateRoom":  func(e *fsm.Event) { m.createRoom(e) },
-			"RejectPost":  func(e *fsm.Event) { m.rejectPost(e) },
-			"ApprovePost": func(e *fsm.Event) { m.approvePost(e) },
+		Replies: map[string]string{
+			"room.created":       "ApprovePost",
+			"create.room.failed": "RejectPost",
 		},
-	)
-
-	m.FSM.SetState(m.state.currentState)
-
-	return m
-}
-
-func (m *CreatePostSagaManager) createRoom(e *fsm.Event) {
-	ctx, ok := e.Args[0].(context.Context)
-	if !ok {
-		e.Cancel(errors.New("missing context"))
-		return
-	}
-	// 遷移先のステートを入れる
-	sagaIn, err := m.state.convSagaInstance(e.Dst)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	event, err := newCreateRoomEvent(&pb.CreateRoom{
-		SagaId: m.state.sagaID,
-		PostId: m.state.post.Id,
-		UserId: m.state.post.UserId,
-	})
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-	// sagaインスタンスの永続化とイベントの発行を同じトランザクション内でやる
-	ctx, err = m.transactionRepo.BeginTx(ctx)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	defer func() {
-		if recover() != nil {
-			m.transactionRepo.Roolback(ctx)
-		}
-	}()
-
-	if err := m.outboxRepo.CreateOutbox(ctx, event); err != nil {
-		m.transactionRepo.Roolback(ctx)
-		e.Cancel(err)
-		return
-	}
-
-	if err := m.sagaInstanceRepo.CreateSagaInstance(ctx, sagaIn); err != nil {
-		m.transactionRepo.Roolback(ctx)
-		e.Cancel(err)
-		return
-	}
-
-	ctx, err = m.transactionRepo.Commit(ctx)
-	if err != nil {
-		e.Cancel(err)
-		return
+		LateReplies: map[string]func(ctx context.Context, s *Saga) (*models.Outbox, error){
+			// リプライを待たずに補償した後でルームが作られた場合は、残ったルームを消す
+			// 再発行したcreate.roomへのリプライは承認した後にも届くので、補償した場合だけにする
+			"room.created": func(ctx context.Context, s *Saga) (*models.Outbox, error) {
+				if !s.FSM.Is("PostRejected") {
+					return nil, nil
+				}
+				return newDeleteRoomEvent(ctx, &pb.DeleteRoom{
+					SagaId: s.ID,
+					PostId: sagaPost(s).Id,
+				})
+			},
+		},
+		Compensation:   "RejectPost",
+		PendingStates:  CreatePostSagaPendingStates,
+		FinishedStates: CreatePostSagaFinishedStates,
 	}
-	// e.Cancel(errors.New("errorおきたよ"))
-	m.state.currentState = e.Dst
 }
 
-func (m *CreatePostSagaManager) rejectPost(e *fsm.Event) {
-	ctx, ok := e.Args[0].(context.Context)
-	if !ok {
-		e.Cancel(errors.New("missing context"))
-		return
-	}
-
-	errMsg, ok := e.Args[1].(string)
-	if !ok {
-		e.Cancel(errors.New("missing error message"))
-		return
-	}
-
-	event, err := newPostRejectedEvent(m.state.post, m.state.sagaID, errMsg)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	jsonPost, err := protojson.Marshal(m.state.post)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	now := time.Now()
-	sagaIn := &models.SagaInstance{
-		ID:           m.state.sagaID,
-		SagaType:     m.state.sagaType,
-		SagaData:     jsonPost,
-		CurrentState: e.Dst,
-		CreatedAt:    now,
-		UpdatedAt:    now,
-	}
-
-	// createPostSagaFailedとサガイベントも発行する
-	ctx, err = m.transactionRepo.BeginTx(ctx)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	defer func() {
-		if recover() != nil {
-			m.transactionRepo.Roolback(ctx)
-		}
-	}()
-
-	if err := m.postRepo.DeletePost(ctx, m.state.post.Id); err != nil {
-		m.transactionRepo.Roolback(ctx)
-		e.Cancel(err)
-		return
-	}
-
-	if err := m.outboxRepo.CreateOutbox(ctx, event); err != nil {
-		m.transactionRepo.Roolback(ctx)
-		e.Cancel(err)
-		return
-	}
-
-	if err := m.sagaInstanceRepo.UpdateSagaInstance(ctx, sagaIn); err != nil {
-		m.transactionRepo.Roolback(ctx)
-		e.Cancel(err)
-		return
-	}
-
-	ctx, err = m.transactionRepo.Commit(ctx)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
+func sagaPost(s *Saga) *pb.Post {
+	return s.Data.(*pb.Post)
 }
 
-func (m *CreatePostSagaManager) approvePost(e *fsm.Event) {
-	ctx, ok := e.Args[0].(context.Context)
-	if !ok {
-		e.Cancel(errors.New("missing context"))
-		return
-	}
-
-	event, err := newPostApprovedEvent(m.state.post, m.state.sagaID)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	jsonPost, err := protojson.Marshal(m.state.post)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	sagaIn := &models.SagaInstance{
-		ID:           m.state.sagaID,
-		SagaType:     m.state.sagaType,
-		SagaData:     jsonPost,
-		CurrentState: e.Dst,
-		UpdatedAt:    time.Now(),
-	}
-
-	ctx, err = m.transactionRepo.BeginTx(ctx)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	defer func() {
-		if recover() != nil {
-			m.transactionRepo.Roolback(ctx)
-		}
-	}()
-
-	if err := m.outboxRepo.CreateOutbox(ctx, event); err != nil {
-		m.transactionRepo.Roolback(ctx)
-		e.Cancel(err)
-		return
-	}
-
-	if err := m.sagaInstanceRepo.UpdateSagaInstance(ctx, sagaIn); err != nil {
-		m.transactionRepo.Roolback(ctx)
-		e.Cancel(err)
-		return
-	}
-
-	ctx, err = m.transactionRepo.Commit(ctx)
-	if err != nil {
-		e.Cancel(err)
-		return
-	}
-
-	m.state.currentState = e.Dst
+// イベントにはステップで変えた後のステータスを入れる。saga_dataの投稿は作成時のまま残す
+func sagaPostWithStatus(s *Saga, st pb.Post_Status) *pb.Post {
+	p := proto.Clone(sagaPost(s)).(*pb.Post)
+	p.Status = st
+	return p
 }
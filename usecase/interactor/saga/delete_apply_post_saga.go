@@ -0,0 +1,131 @@
+package saga
+
+import (
+	"context"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/pb"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/proto"
+)
+
+const DeleteApplyPostSagaType = "DeleteApplyPostSaga"
+
+// 途中で落ちると止まったままになるステート
+var DeleteApplyPostSagaPendingStates = []string{"init", "RemovingMember"}
+
+// これ以上ステートが変わらないステート
+var DeleteApplyPostSagaFinishedStates = []string{"ApplyDeleted", "DeleteApplyRejected"}
+
+// DeleteApplyPostSagaの定義。saga_dataには応募を入れる
+// 応募者をチャットルームから外せたら応募を取り消し済みにする。外せなければメンバーに戻して応募を残す
+func NewDeleteApplyPostSagaDefinition(pr repo.PostRepo, ar repo.ApplyPostRepo, or repo.OutboxRepo, cr repo.ChatRepo) *Definition {
+	return &Definition{
+		SagaType: DeleteApplyPostSagaType,
+		NewData:  func() proto.Message { return &pb.ApplyPost{} },
+		Steps: []*Step{
+			{
+				Name: "RemoveMember", Src: []string{"init"}, Dst: "RemovingMember",
+				// メンバーから外す途中で落ちても補償できるように、先にステートを進めておく
+				After: func(ctx context.Context, s *Saga, in *StepInput) error {
+					return cr.DeleteMember(ctx, sagaApplyPost(s).PostId, sagaApplyPost(s).UserId)
+				},
+			},
+			{
+				Name: "RejectDeleteApply", Src: []string{"init", "RemovingMember"}, Dst: "DeleteApplyRejected",
+				// 外せていた場合に備えてメンバーに戻す。失敗した場合はステートを変えずに返して、あとでやり直す
+				Before: func(ctx context.Context, s *Saga, in *StepInput) error {
+					return cr.CreateMember(ctx, sagaApplyPost(s).PostId, sagaApplyPost(s).UserId)
+				},
+				Compensation: true,
+			},
+			{
+				Name: "DeleteApply", Src: []string{"RemovingMember"}, Dst: "ApplyDeleted",
+				Local: func(ctx context.Context, s *Saga, in *StepInput) error {
+					now := time.Now()
+
+					// 定員の変更や承認と同時に繰り上げないように、他の処理と同じく投稿の行を最初にロックする
+					p, err := pr.GetPostByIDForUpdate(ctx, sagaApplyPost(s).PostId)
+					if err != nil {
+						return err
+					}
+
+					// 応募は消さずに取り消し済みにする
+					if err := ar.UpdateApplyPostStatus(ctx, sagaApplyPost(s).Id, models.ApplyPostStatusWithdrawn, now); err != nil {
+						return err
+					}
+
+					cnt, err := ar.CountAcceptedSeatsByPostID(ctx, p.ID)
+					if err != nil {
+						return err
+					}
+
+					// 承認済みの応募が抜けて空いた人数分、古い順に空き待ちの応募を繰り上げる。定員の残りは超えない
+					seats := sagaApplyPost(s).PartySize
+					if rest := p.MaxApply - cnt; rest < seats {
+						seats = rest
+					}
+
+					return PromoteWaitlistedApplyPosts(ctx, ar, or, p.ID, seats, now)
+				},
+				Command: func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error) {
+					return NewApplyPostWithdrawnEvent(ctx, sagaApplyPostWithStatus(s, pb.ApplyPost_WITHDRAWN))
+				},
+			},
+		},
+		Compensation:   "RejectDeleteApply",
+		PendingStates:  DeleteApplyPostSagaPendingStates,
+		FinishedStates: DeleteApplyPostSagaFinishedStates,
+	}
+}
+
+// 空き待ちの応募を古い順に、合計人数がseatsに収まるだけpendingに繰り上げる
+// 承認済みの応募の取り消しと定員の変更で使う。投稿の行をロックしたトランザクションのctxで呼ぶ
+func PromoteWaitlistedApplyPosts(ctx context.Context, ar repo.ApplyPostRepo, or repo.OutboxRepo, postID int64, seats int64, now time.Time) error {
+	if seats <= 0 {
+		return nil
+	}
+
+	list, err := ar.ListWaitlistedApplyPostsByPostID(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range models.TakeApplyPostsWithinSeats(list, seats) {
+		if err := ar.UpdateApplyPostStatus(ctx, a.ID, models.ApplyPostStatusPending, now); err != nil {
+			return err
+		}
+
+		cAt, err := ptypes.TimestampProto(a.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		uAt, err := ptypes.TimestampProto(now)
+		if err != nil {
+			return err
+		}
+
+		event, err := newApplyPostPromotedEvent(ctx, &pb.ApplyPost{
+			Id:        a.ID,
+			PostId:    a.PostID,
+			UserId:    a.UserID,
+			Status:    pb.ApplyPost_PENDING,
+			PartySize: a.PartySize,
+			Note:      a.Note,
+			CreatedAt: cAt,
+			UpdatedAt: uAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := or.CreateOutbox(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package saga
+
+import (
+	"context"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/pb"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+const DeletePostSagaType = "DeletePostSaga"
+
+// 途中で落ちたりリプライが返ってこなかったりすると、止まったままになるステート
+var DeletePostSagaPendingStates = []string{"init", "DeletingRoom", "DeletingPost"}
+
+// これ以上ステートが変わらないステート
+var DeletePostSagaFinishedStates = []string{"PostDeleted", "DeletePostRejected"}
+
+// DeletePostSagaの定義。saga_dataには投稿を入れる
+// チャットルームを消せたことを確認してから、画像と投稿を消す
+// ルームの削除に失敗した場合は何も消さずに終わるので、補償で戻すものはない
+// ルームが消えた後は戻せないので、画像と投稿の削除は補償せずに終わるまでやり直す
+func NewDeletePostSagaDefinition(pr repo.PostRepo, ir repo.ImageRepo) *Definition {
+	return &Definition{
+		SagaType: DeletePostSagaType,
+		NewData:  func() proto.Message { return &pb.Post{} },
+		Steps: []*Step{
+			{
+				Name: "DeleteRoom", Src: []string{"init"}, Dst: "DeletingRoom",
+				Command: func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error) {
+					return newDeleteRoomEvent(ctx, &pb.DeleteRoom{
+						SagaId: s.ID,
+						PostId: sagaPost(s).Id,
+					})
+				},
+				Retryable: true,
+			},
+			{
+				Name: "RejectDeletePost", Src: []string{"init", "DeletingRoom"}, Dst: "DeletePostRejected",
+				Command: func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error) {
+					return newPostDeleteRejectedEvent(ctx, sagaPost(s), s.ID, in.Reason)
+				},
+				Compensation: true,
+			},
+			{
+				// リプライを記録してステートだけを進める。ここから先はRejectDeletePostできない
+				Name: "ConfirmRoomDeleted", Src: []string{"DeletingRoom"}, Dst: "DeletingPost",
+			},
+			{
+				Name: "DeletePost", Src: []string{"DeletingPost"}, Dst: "PostDeleted",
+				// 画像の削除に失敗した場合は、DeletingPostで止まったサガとしてあとでやり直す
+				Before: func(ctx context.Context, s *Saga, in *StepInput) error {
+					return ir.DeleteImagesByPostID(ctx, sagaPost(s).Id)
+				},
+				Local: func(ctx context.Context, s *Saga, in *StepInput) error {
+					return pr.DeletePost(ctx, sagaPost(s).Id)
+				},
+				Command: func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error) {
+					return newPostDeletedEvent(ctx, sagaPost(s))
+				},
+				Resume: true,
+			},
+		},
+		Replies: map[string]string{
+			"room.deleted":       "ConfirmRoomDeleted",
+			"delete.room.failed": "RejectDeletePost",
+		},
+		Compensation:   "RejectDeletePost",
+		PendingStates:  DeletePostSagaPendingStates,
+		FinishedStates: DeletePostSagaFinishedStates,
+	}
+}
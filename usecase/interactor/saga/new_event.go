@@ -1,32 +1,37 @@
 package saga
 
 import (
-	"time"
+	"context"
+	"strconv"
 
 	"github.com/ezio1119/fishapp-post/models"
 	"github.com/ezio1119/fishapp-post/pb"
-	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func newCreateRoomEvent(c *pb.CreateRoom) (*models.Outbox, error) {
+func newCreateRoomEvent(ctx context.Context, c *pb.CreateRoom) (*models.Outbox, error) {
 	eventData, err := protojson.Marshal(c)
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now()
-
-	return &models.Outbox{
-		ID:        uuid.New().String(),
-		EventType: "create.room",
-		EventData: eventData,
-		Channel:   "create.room",
-		CreatedAt: now,
-		UpdatedAt: now,
-	}, nil
+
+	return models.NewOutbox(ctx, "create.room", "create.room", eventData), nil
+}
+
+func newPostCreatedEvent(ctx context.Context, p *pb.Post) (*models.Outbox, error) {
+	eventData, err := protojson.Marshal(&pb.PostCreated{Post: p})
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.NewOutbox(ctx, "post.created", "post.created", eventData)
+	event.AggregateID = strconv.FormatInt(p.Id, 10)
+	event.AggregateType = "post"
+
+	return event, nil
 }
 
-func newPostApprovedEvent(p *pb.Post, sagaID string) (*models.Outbox, error) {
+func newPostApprovedEvent(ctx context.Context, p *pb.Post, sagaID string) (*models.Outbox, error) {
 	postApproved := &pb.PostApproved{
 		SagaId: sagaID,
 		Post:   p,
@@ -37,18 +42,10 @@ func newPostApprovedEvent(p *pb.Post, sagaID string) (*models.Outbox, error) {
 		return nil, err
 	}
 
-	now := time.Now()
-	return &models.Outbox{
-		ID:        uuid.New().String(),
-		EventType: "post.approved",
-		EventData: jsonEvent,
-		Channel:   "create.post.result",
-		CreatedAt: now,
-		UpdatedAt: now,
-	}, nil
+	return models.NewOutbox(ctx, "post.approved", "create.post.result", jsonEvent), nil
 }
 
-func newPostRejectedEvent(p *pb.Post, sagaID string, errMsg string) (*models.Outbox, error) {
+func newPostRejectedEvent(ctx context.Context, p *pb.Post, sagaID string, errMsg string) (*models.Outbox, error) {
 	postRejected := &pb.PostRejected{
 		SagaId:       sagaID,
 		Post:         p,
@@ -60,13 +57,82 @@ func newPostRejectedEvent(p *pb.Post, sagaID string, errMsg string) (*models.Out
 		return nil, err
 	}
 
-	now := time.Now()
-	return &models.Outbox{
-		ID:        uuid.New().String(),
-		EventType: "post.rejected",
-		EventData: jsonEvent,
-		Channel:   "create.post.result",
-		CreatedAt: now,
-		UpdatedAt: now,
-	}, nil
+	return models.NewOutbox(ctx, "post.rejected", "create.post.result", jsonEvent), nil
+}
+
+func newDeleteRoomEvent(ctx context.Context, d *pb.DeleteRoom) (*models.Outbox, error) {
+	eventData, err := protojson.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewOutbox(ctx, "delete.room", "delete.room", eventData), nil
+}
+
+func newPostDeletedEvent(ctx context.Context, p *pb.Post) (*models.Outbox, error) {
+	eventData, err := protojson.Marshal(&pb.PostDeleted{Post: p})
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.NewOutbox(ctx, "post.deleted", "post.deleted", eventData)
+	event.AggregateID = strconv.FormatInt(p.Id, 10)
+	event.AggregateType = "post"
+
+	return event, nil
+}
+
+func newPostDeleteRejectedEvent(ctx context.Context, p *pb.Post, sagaID string, errMsg string) (*models.Outbox, error) {
+	postDeleteRejected := &pb.PostDeleteRejected{
+		SagaId:       sagaID,
+		Post:         p,
+		ErrorMessage: errMsg,
+	}
+
+	jsonEvent, err := protojson.Marshal(postDeleteRejected)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewOutbox(ctx, "post.delete.rejected", "delete.post.result", jsonEvent), nil
+}
+
+func newApplyPostAcceptedEvent(ctx context.Context, a *pb.ApplyPost) (*models.Outbox, error) {
+	applyPostAccepted, err := protojson.Marshal(&pb.ApplyPostAccepted{ApplyPost: a})
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.NewOutbox(ctx, "apply.post.accepted", "apply.post.accepted", applyPostAccepted)
+	event.AggregateID = strconv.FormatInt(a.Id, 10)
+	event.AggregateType = "apply.post"
+
+	return event, nil
+}
+
+// 応募の取り消しはDeleteApplyPostSagaとPostInteractorの両方で発行する
+func NewApplyPostWithdrawnEvent(ctx context.Context, a *pb.ApplyPost) (*models.Outbox, error) {
+	applyPostWithdrawn, err := protojson.Marshal(&pb.ApplyPostWithdrawn{ApplyPost: a})
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.NewOutbox(ctx, "apply.post.withdrawn", "apply.post.withdrawn", applyPostWithdrawn)
+	event.AggregateID = strconv.FormatInt(a.Id, 10)
+	event.AggregateType = "apply.post"
+
+	return event, nil
+}
+
+func newApplyPostPromotedEvent(ctx context.Context, a *pb.ApplyPost) (*models.Outbox, error) {
+	applyPostPromoted, err := protojson.Marshal(&pb.ApplyPostPromoted{ApplyPost: a})
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.NewOutbox(ctx, "apply.post.promoted", "apply.post.promoted", applyPostPromoted)
+	event.AggregateID = strconv.FormatInt(a.Id, 10)
+	event.AggregateType = "apply.post"
+
+	return event, nil
 }
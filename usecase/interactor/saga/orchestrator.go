@@ -0,0 +1,557 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"github.com/looplab/fsm"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// サガの1ステップ。FSMのイベント1つに対応する
+// ステートとイベントの永続化はOrchestratorが行うので、ステップにはそのステップ固有の処理だけを書く
+type Step struct {
+	Name string
+	Src  []string
+	Dst  string
+
+	// トランザクションの前に呼ぶ。外部サービスの後始末などを書く
+	// before_<ステップ名>のコールバックで呼ぶので、失敗した場合はFSMのステートも永続化したステートも変わらない
+	Before func(ctx context.Context, s *Saga, in *StepInput) error
+
+	// ステートの永続化と同じトランザクションで呼ぶ。ローカルのテーブルの変更を書く
+	Local func(ctx context.Context, s *Saga, in *StepInput) error
+
+	// 発行するコマンドやイベントを返す。ステートの永続化と同じトランザクションでoutboxに書き込む
+	Command func(ctx context.Context, s *Saga, in *StepInput) (*models.Outbox, error)
+
+	// コミットした後にafter_<ステップ名>のコールバックで呼ぶ
+	// 失敗してもステートは進んだままなので、補償で後始末できる処理だけを書く
+	After func(ctx context.Context, s *Saga, in *StepInput) error
+
+	// trueの場合、リプライが返ってこないときにCommandをもう一度発行できる
+	Retryable bool
+
+	// trueの場合、補償できなくなった後のステップ。リプライを待たずに続けて進め、
+	// Srcのステートで止まっているサガは補償せずにこのステップをやり直す
+	Resume bool
+
+	// trueの場合、補償のステップ。理由をreject_reasonに残す
+	Compensation bool
+}
+
+// ステップに渡す値。どれも必要なステップだけが使う
+type StepInput struct {
+	Data   interface{}
+	Reason string
+	Msg    *models.ReceivedMessage // リプライを受けて進める場合は、同じトランザクションで処理済みとして記録する
+}
+
+// サガの種類ごとの定義
+type Definition struct {
+	SagaType string
+
+	// saga_dataに入れる値の型
+	NewData func() proto.Message
+
+	Steps []*Step
+
+	// リプライのイベントタイプから、進めるステップ名への対応
+	Replies map[string]string
+
+	// 進められないステートで届いたリプライのイベントタイプから、後始末のコマンドを作る関数への対応
+	// 関数がnilを返す場合や対応が無い場合は、遅れて届いたリプライとして何もせずに捨てる
+	LateReplies map[string]func(ctx context.Context, s *Saga) (*models.Outbox, error)
+
+	// タイムアウトや管理者の操作で補償するときのステップ名
+	Compensation string
+
+	PendingStates  []string
+	FinishedStates []string
+}
+
+func (d *Definition) step(name string) *Step {
+	for _, st := range d.Steps {
+		if st.Name == name {
+			return st
+		}
+	}
+	return nil
+}
+
+// 状態を持たないので全てのリクエストとリプライで共有できる
+type Orchestrator struct {
+	outboxRepo          repo.OutboxRepo
+	sagaInstanceRepo    repo.SagaInstanceRepo
+	sagaTransitionRepo  repo.SagaTransitionRepo
+	receivedMessageRepo repo.ReceivedMessageRepo
+	transactionRepo     repo.TransactionRepo
+	definitions         map[string]*Definition
+}
+
+func NewOrchestrator(
+	or repo.OutboxRepo,
+	sr repo.SagaInstanceRepo,
+	str repo.SagaTransitionRepo,
+	rr repo.ReceivedMessageRepo,
+	tr repo.TransactionRepo,
+) *Orchestrator {
+	return &Orchestrator{
+		outboxRepo:          or,
+		sagaInstanceRepo:    sr,
+		sagaTransitionRepo:  str,
+		receivedMessageRepo: rr,
+		transactionRepo:     tr,
+		definitions:         map[string]*Definition{},
+	}
+}
+
+// 起動時に呼ぶ。リクエストの処理中には呼ばない
+func (o *Orchestrator) Register(d *Definition) {
+	o.definitions[d.SagaType] = d
+}
+
+func (o *Orchestrator) Definition(sagaType string) (*Definition, bool) {
+	d, ok := o.definitions[sagaType]
+	return d, ok
+}
+
+// saga_dataのidがdataIDで、終了していないsagaTypeのサガがあるかを返す
+func (o *Orchestrator) ExistsUnfinished(ctx context.Context, sagaType string, dataID int64) (bool, error) {
+	d, ok := o.definitions[sagaType]
+	if !ok {
+		return false, fmt.Errorf("saga type %s is not registered", sagaType)
+	}
+
+	return o.sagaInstanceRepo.ExistsUnfinishedSagaInstance(ctx, sagaType, d.FinishedStates, dataID)
+}
+
+// sagaTypeの定義で、stateが終了ステートかどうかを返す。登録されていない種類はfalseにする
+func (o *Orchestrator) IsFinished(sagaType string, state string) bool {
+	d, ok := o.definitions[sagaType]
+	if !ok {
+		return false
+	}
+
+	return containsState(d.FinishedStates, state)
+}
+
+// 登録した全ての定義をsaga_type順に返す
+func (o *Orchestrator) Definitions() []*Definition {
+	list := make([]*Definition, 0, len(o.definitions))
+	for _, d := range o.definitions {
+		list = append(list, d)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].SagaType < list[j].SagaType })
+	return list
+}
+
+// 新しいサガをinitステートで作る。永続化はBeginで行う
+func (o *Orchestrator) New(sagaType string, sagaID string, data proto.Message) (*Saga, error) {
+	d, ok := o.definitions[sagaType]
+	if !ok {
+		return nil, fmt.Errorf("saga type %s is not registered", sagaType)
+	}
+
+	now := time.Now()
+	return o.newSaga(d, &models.SagaInstance{
+		ID:           sagaID,
+		SagaType:     sagaType,
+		CurrentState: "init",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, data), nil
+}
+
+// 永続化されたサガインスタンスから、saga_typeに対応する定義でサガを復元する
+func (o *Orchestrator) Load(sagaIn *models.SagaInstance) (*Saga, error) {
+	d, ok := o.definitions[sagaIn.SagaType]
+	if !ok {
+		return nil, fmt.Errorf("saga type %s is not registered", sagaIn.SagaType)
+	}
+
+	data := d.NewData()
+	if err := protojson.Unmarshal(sagaIn.SagaData, data); err != nil {
+		return nil, err
+	}
+
+	return o.newSaga(d, sagaIn, data), nil
+}
+
+func (o *Orchestrator) newSaga(d *Definition, sagaIn *models.SagaInstance, data proto.Message) *Saga {
+	s := &Saga{
+		Orchestrator: o,
+		def:          d,
+		ID:           sagaIn.ID,
+		Data:         data,
+		version:      sagaIn.Version,
+		createdAt:    sagaIn.CreatedAt,
+	}
+
+	// イベント名だけをキーにしたコールバックはafter_<イベント名>になり、キャンセルしても遷移は取り消されない
+	// 永続化まではbefore_で行い、失敗したらFSMのステートも遷移前のままにする
+	events := make(fsm.Events, len(d.Steps))
+	callbacks := make(fsm.Callbacks, len(d.Steps)*2)
+	for n, st := range d.Steps {
+		st := st
+		events[n] = fsm.EventDesc{Name: st.Name, Src: st.Src, Dst: st.Dst}
+		callbacks["before_"+st.Name] = func(e *fsm.Event) { s.runStep(st, e) }
+		callbacks["after_"+st.Name] = func(e *fsm.Event) { s.runAfter(st, e) }
+	}
+
+	s.FSM = fsm.NewFSM("init", events, callbacks)
+	s.FSM.SetState(sagaIn.CurrentState)
+
+	return s
+}
+
+// サガインスタンス1つ分のFSMと状態。インスタンスごとに作り、他のgoroutineと共有しない
+type Saga struct {
+	*Orchestrator
+	def       *Definition
+	FSM       *fsm.FSM
+	ID        string
+	Data      proto.Message
+	version   int64
+	createdAt time.Time
+}
+
+// サガインスタンスをinitステートで永続化する
+// 集約とサガが片方だけ残らないよう、集約の作成と同じトランザクションのctxで呼ぶ
+func (s *Saga) Begin(ctx context.Context) error {
+	sagaIn, err := s.convSagaInstance(s.FSM.Current())
+	if err != nil {
+		return err
+	}
+
+	return s.sagaInstanceRepo.CreateSagaInstance(ctx, sagaIn)
+}
+
+// ステップを進める。失敗した場合はステップが返したエラーをそのまま返す
+func (s *Saga) Fire(ctx context.Context, step string, in *StepInput) error {
+	return fireEvent(s.FSM, step, ctx, in)
+}
+
+// リプライのイベントタイプに対応するステップを進める
+// 補償した後などで進められない場合は、再配信しても進められないのでエラーにせずにhandleLateReplyで処理する
+func (s *Saga) HandleReply(ctx context.Context, msg *models.ReceivedMessage, errMsg string) error {
+	step, ok := s.def.Replies[msg.EventType]
+	if !ok || s.FSM.Cannot(step) {
+		return s.handleLateReply(ctx, msg)
+	}
+
+	if err := s.Fire(ctx, step, &StepInput{Reason: errMsg, Msg: msg}); err != nil {
+		return err
+	}
+
+	// リプライは記録済みなので、続くステップに失敗しても止まったサガとしてあとでやり直す
+	if s.CanResume() {
+		if err := s.Resume(ctx); err != nil {
+			log.Printf("error failed resume saga id=%s: %s", s.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// 遅れて届いたリプライの後始末のコマンドを、リプライの記録と同じトランザクションでoutboxに書き込む
+// ステートは変えないが、発行したコマンドは遷移の履歴に残す
+func (s *Saga) handleLateReply(ctx context.Context, msg *models.ReceivedMessage) error {
+	var event *models.Outbox
+	if f := s.def.LateReplies[msg.EventType]; f != nil {
+		var err error
+		event, err = f(ctx, s)
+		if err != nil {
+			return err
+		}
+	}
+
+	if event == nil {
+		log.Printf("saga id=%s in state %s ignored late reply %s", s.ID, s.FSM.Current(), msg.EventType)
+		return nil
+	}
+
+	ctx, err := s.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if recover() != nil {
+			s.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	if err := s.receivedMessageRepo.CreateReceivedMessage(ctx, msg); err != nil {
+		s.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if err := s.outboxRepo.CreateOutbox(ctx, event); err != nil {
+		s.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if err := s.sagaTransitionRepo.CreateSagaTransition(ctx, &models.SagaTransition{
+		SagaID:       s.ID,
+		Event:        "HandleLateReply",
+		FromState:    s.FSM.Current(),
+		ToState:      s.FSM.Current(),
+		Payload:      event.EventData,
+		ErrorMessage: "late reply " + msg.EventType,
+		CreatedAt:    time.Now(),
+	}); err != nil {
+		s.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if _, err := s.transactionRepo.Commit(ctx); err != nil {
+		return err
+	}
+
+	log.Printf("saga id=%s in state %s compensated late reply %s", s.ID, s.FSM.Current(), msg.EventType)
+	return nil
+}
+
+func (s *Saga) Compensate(ctx context.Context, reason string) error {
+	return s.Fire(ctx, s.def.Compensation, &StepInput{Reason: reason})
+}
+
+// 今のステートでコマンドを再発行できるか
+func (s *Saga) CanRetry() bool {
+	return s.retryableStep() != nil
+}
+
+// リプライが返ってこないときに、ステートはそのままで今のステートに入ったときのコマンドをもう一度発行する
+func (s *Saga) Retry(ctx context.Context) error {
+	st := s.retryableStep()
+	if st == nil {
+		return fmt.Errorf("cannot retry %s in state %s", s.def.SagaType, s.FSM.Current())
+	}
+
+	event, err := st.Command(ctx, s, &StepInput{})
+	if err != nil {
+		return err
+	}
+
+	ctx, err = s.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if recover() != nil {
+			s.transactionRepo.Roolback(ctx)
+		}
+	}()
+
+	if err := s.outboxRepo.CreateOutbox(ctx, event); err != nil {
+		s.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if err := s.sagaInstanceRepo.IncrementSagaRetryCount(ctx, s.ID, s.version, time.Now()); err != nil {
+		s.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if err := s.sagaTransitionRepo.CreateSagaTransition(ctx, &models.SagaTransition{
+		SagaID:    s.ID,
+		Event:     "Retry" + st.Name,
+		FromState: s.FSM.Current(),
+		ToState:   s.FSM.Current(),
+		Payload:   event.EventData,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		s.transactionRepo.Roolback(ctx)
+		return err
+	}
+
+	if _, err := s.transactionRepo.Commit(ctx); err != nil {
+		return err
+	}
+
+	s.version++
+	return nil
+}
+
+// 今のステートから、補償せずに進め直すステップがあるか
+func (s *Saga) CanResume() bool {
+	return s.resumableStep() != nil
+}
+
+// 補償できなくなった後のステップを進める。何度失敗してもやり直せるように、ステップの処理は冪等にする
+func (s *Saga) Resume(ctx context.Context) error {
+	st := s.resumableStep()
+	if st == nil {
+		return fmt.Errorf("cannot resume %s in state %s", s.def.SagaType, s.FSM.Current())
+	}
+
+	return s.Fire(ctx, st.Name, &StepInput{})
+}
+
+func (s *Saga) resumableStep() *Step {
+	for _, st := range s.def.Steps {
+		if st.Resume && containsState(st.Src, s.FSM.Current()) {
+			return st
+		}
+	}
+	return nil
+}
+
+func (s *Saga) retryableStep() *Step {
+	for _, st := range s.def.Steps {
+		if st.Retryable && st.Dst == s.FSM.Current() {
+			return st
+		}
+	}
+	return nil
+}
+
+func (s *Saga) convSagaInstance(state string) (*models.SagaInstance, error) {
+	jsonData, err := protojson.Marshal(s.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SagaInstance{
+		ID:           s.ID,
+		SagaType:     s.def.SagaType,
+		SagaData:     jsonData,
+		CurrentState: state,
+		Version:      s.version,
+		CreatedAt:    s.createdAt,
+		UpdatedAt:    time.Now(),
+	}, nil
+}
+
+// 全てのステップで共通のbefore_<ステップ名>のコールバック
+// ステートの永続化、outboxへの書き込み、遷移の記録、リプライの記録を1つのトランザクションで行う
+// キャンセルするとFSMは遷移しないので、FSMのステートは永続化したステートと同じになる
+func (s *Saga) runStep(st *Step, e *fsm.Event) {
+	ctx, ok := e.Args[0].(context.Context)
+	if !ok {
+		e.Cancel(errors.New("missing context"))
+		return
+	}
+
+	in := &StepInput{}
+	if len(e.Args) > 1 {
+		in, ok = e.Args[1].(*StepInput)
+		if !ok {
+			e.Cancel(errors.New("invalid step input"))
+			return
+		}
+	}
+
+	if st.Before != nil {
+		if err := st.Before(ctx, s, in); err != nil {
+			e.Cancel(err)
+			return
+		}
+	}
+
+	var event *models.Outbox
+	if st.Command != nil {
+		var err error
+		event, err = st.Command(ctx, s, in)
+		if err != nil {
+			e.Cancel(err)
+			return
+		}
+	}
+
+	// 遷移先のステートを入れる
+	sagaIn, err := s.convSagaInstance(e.Dst)
+	if err != nil {
+		e.Cancel(err)
+		return
+	}
+
+	errMsg := ""
+	if st.Compensation {
+		errMsg = in.Reason
+		sagaIn.RejectReason = in.Reason
+	}
+
+	txCtx, err := s.transactionRepo.BeginTx(ctx)
+	if err != nil {
+		e.Cancel(err)
+		return
+	}
+
+	defer func() {
+		if recover() != nil {
+			s.transactionRepo.Roolback(txCtx)
+		}
+	}()
+
+	if in.Msg != nil {
+		if err := s.receivedMessageRepo.CreateReceivedMessage(txCtx, in.Msg); err != nil {
+			s.transactionRepo.Roolback(txCtx)
+			e.Cancel(err)
+			return
+		}
+	}
+
+	if st.Local != nil {
+		if err := st.Local(txCtx, s, in); err != nil {
+			s.transactionRepo.Roolback(txCtx)
+			e.Cancel(err)
+			return
+		}
+	}
+
+	var payload []byte
+	if event != nil {
+		if err := s.outboxRepo.CreateOutbox(txCtx, event); err != nil {
+			s.transactionRepo.Roolback(txCtx)
+			e.Cancel(err)
+			return
+		}
+		payload = event.EventData
+	}
+
+	if err := s.sagaInstanceRepo.UpdateSagaInstance(txCtx, sagaIn); err != nil {
+		s.transactionRepo.Roolback(txCtx)
+		e.Cancel(err)
+		return
+	}
+
+	if err := s.sagaTransitionRepo.CreateSagaTransition(txCtx, newSagaTransition(s.ID, e, payload, errMsg)); err != nil {
+		s.transactionRepo.Roolback(txCtx)
+		e.Cancel(err)
+		return
+	}
+
+	if _, err := s.transactionRepo.Commit(txCtx); err != nil {
+		e.Cancel(err)
+		return
+	}
+
+	s.version = sagaIn.Version
+}
+
+// 全てのステップで共通のafter_<ステップ名>のコールバック。ステートはもう進んでいる
+func (s *Saga) runAfter(st *Step, e *fsm.Event) {
+	if st.After == nil {
+		return
+	}
+
+	ctx := e.Args[0].(context.Context)
+
+	in := &StepInput{}
+	if len(e.Args) > 1 {
+		in = e.Args[1].(*StepInput)
+	}
+
+	if err := st.After(ctx, s, in); err != nil {
+		e.Cancel(err)
+	}
+}
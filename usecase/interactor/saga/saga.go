@@ -0,0 +1,43 @@
+package saga
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/looplab/fsm"
+)
+
+func containsState(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// FSMのイベントを起こす
+// before_でキャンセルしたエラーはCanceledErrorに包まれるので、errors.Asやstatus.Codeで判定できるように取り出して返す
+func fireEvent(f *fsm.FSM, event string, args ...interface{}) error {
+	err := f.Event(event, args...)
+
+	var canceledErr fsm.CanceledError
+	if errors.As(err, &canceledErr) && canceledErr.Err != nil {
+		return canceledErr.Err
+	}
+	return err
+}
+
+// FSMのイベントからステート遷移の履歴を作る。payloadには遷移で発行したイベントを入れる
+func newSagaTransition(sagaID string, e *fsm.Event, payload []byte, errMsg string) *models.SagaTransition {
+	return &models.SagaTransition{
+		SagaID:       sagaID,
+		Event:        e.Event,
+		FromState:    e.Src,
+		ToState:      e.Dst,
+		Payload:      payload,
+		ErrorMessage: errMsg,
+		CreatedAt:    time.Now(),
+	}
+}
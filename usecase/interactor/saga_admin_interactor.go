@@ -0,0 +1,120 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/conf"
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/interactor/saga"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"github.com/looplab/fsm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SagaAdminInteractor interface {
+	ListSagaInstances(ctx context.Context, f *models.SagaInstanceFilter, pageSize int64) ([]*models.SagaInstance, error)
+	RetrySagaStep(ctx context.Context, sagaID string) (*models.SagaInstance, error)
+	ForceCompensate(ctx context.Context, sagaID string, reason string) (*models.SagaInstance, error)
+}
+
+type sagaAdminInteractor struct {
+	sagaOrchestrator *saga.Orchestrator
+	sagaInstanceRepo repo.SagaInstanceRepo
+	ctxTimeout       time.Duration
+}
+
+func NewSagaAdminInteractor(
+	so *saga.Orchestrator,
+	sr repo.SagaInstanceRepo,
+	timeout time.Duration,
+) SagaAdminInteractor {
+	return &sagaAdminInteractor{so, sr, timeout}
+}
+
+func (i *sagaAdminInteractor) ListSagaInstances(ctx context.Context, f *models.SagaInstanceFilter, pageSize int64) ([]*models.SagaInstance, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	if pageSize == 0 {
+		pageSize = conf.C.Sv.DefaultPageSize
+	}
+
+	list, err := i.sagaInstanceRepo.ListSagaInstances(ctx, f, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	setSagaFinished(i.sagaOrchestrator, list...)
+	return list, nil
+}
+
+// 今のステートで待っているコマンドを再発行するか、補償できなくなった後のステップを進め直す
+// どちらもできないステートではFailedPreconditionを返す
+func (i *sagaAdminInteractor) RetrySagaStep(ctx context.Context, sagaID string) (*models.SagaInstance, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	sagaIn, err := i.sagaInstanceRepo.GetSagaInstance(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := i.sagaOrchestrator.Load(sagaIn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case s.CanResume():
+		if err := s.Resume(ctx); err != nil {
+			return nil, err
+		}
+	case s.CanRetry():
+		if err := s.Retry(ctx); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "%s in state %s has no command to retry", sagaIn.SagaType, sagaIn.CurrentState)
+	}
+
+	return i.getSagaInstance(ctx, sagaID)
+}
+
+// 今のステートから補償のイベントを起こしてサガを終わらせる。補償できないステートではFailedPreconditionを返す
+func (i *sagaAdminInteractor) ForceCompensate(ctx context.Context, sagaID string, reason string) (*models.SagaInstance, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	sagaIn, err := i.sagaInstanceRepo.GetSagaInstance(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := i.sagaOrchestrator.Load(sagaIn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Compensate(ctx, "compensated by admin: "+reason); err != nil {
+		var invalidErr fsm.InvalidEventError
+		if errors.As(err, &invalidErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s in state %s cannot be compensated", sagaIn.SagaType, sagaIn.CurrentState)
+		}
+		return nil, err
+	}
+
+	return i.getSagaInstance(ctx, sagaID)
+}
+
+func (i *sagaAdminInteractor) getSagaInstance(ctx context.Context, sagaID string) (*models.SagaInstance, error) {
+	sagaIn, err := i.sagaInstanceRepo.GetSagaInstance(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+
+	setSagaFinished(i.sagaOrchestrator, sagaIn)
+	return sagaIn, nil
+}
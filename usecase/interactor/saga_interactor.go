@@ -0,0 +1,98 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/usecase/interactor/saga"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+)
+
+type SagaInteractor interface {
+	GetSagaInstance(ctx context.Context, sagaID string) (*models.SagaInstance, error)
+	WatchSagaInstance(ctx context.Context, sagaID string, send func(*models.SagaInstance) error) error
+	ListSagaTransitions(ctx context.Context, sagaID string) ([]*models.SagaTransition, error)
+}
+
+type sagaInteractor struct {
+	sagaOrchestrator   *saga.Orchestrator
+	sagaInstanceRepo   repo.SagaInstanceRepo
+	sagaTransitionRepo repo.SagaTransitionRepo
+	ctxTimeout         time.Duration
+	watchInterval      time.Duration
+}
+
+func NewSagaInteractor(
+	so *saga.Orchestrator,
+	sr repo.SagaInstanceRepo,
+	str repo.SagaTransitionRepo,
+	timeout time.Duration,
+	watchInterval time.Duration,
+) SagaInteractor {
+	return &sagaInteractor{so, sr, str, timeout, watchInterval}
+}
+
+func (i *sagaInteractor) GetSagaInstance(ctx context.Context, sagaID string) (*models.SagaInstance, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	sagaIn, err := i.sagaInstanceRepo.GetSagaInstance(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+
+	setSagaFinished(i.sagaOrchestrator, sagaIn)
+	return sagaIn, nil
+}
+
+// サガインスタンスをポーリングして、ステートが変わるたびにsendを呼ぶ
+// 終了ステートになるかctxがキャンセルされるまで返らない
+func (i *sagaInteractor) WatchSagaInstance(ctx context.Context, sagaID string, send func(*models.SagaInstance) error) error {
+	t := time.NewTicker(i.watchInterval)
+	defer t.Stop()
+
+	lastState := ""
+	for {
+		sagaIn, err := i.GetSagaInstance(ctx, sagaID)
+		if err != nil {
+			return err
+		}
+
+		if sagaIn.CurrentState != lastState {
+			if err := send(sagaIn); err != nil {
+				return err
+			}
+			lastState = sagaIn.CurrentState
+		}
+
+		if sagaIn.Finished {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func (i *sagaInteractor) ListSagaTransitions(ctx context.Context, sagaID string) ([]*models.SagaTransition, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	// 存在しないサガはNotFoundにする
+	if _, err := i.sagaInstanceRepo.GetSagaInstance(ctx, sagaID); err != nil {
+		return nil, err
+	}
+
+	return i.sagaTransitionRepo.ListSagaTransitions(ctx, sagaID)
+}
+
+// 終了したかは保存していないので、登録したサガの定義から決める
+func setSagaFinished(so *saga.Orchestrator, list ...*models.SagaInstance) {
+	for _, sagaIn := range list {
+		sagaIn.Finished = so.IsFinished(sagaIn.SagaType, sagaIn.CurrentState)
+	}
+}
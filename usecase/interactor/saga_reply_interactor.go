@@ -2,77 +2,114 @@ package interactor
 
 import (
 	"context"
+	"errors"
 	"log"
+	"time"
 
-	"github.com/ezio1119/fishapp-post/pb"
+	"github.com/ezio1119/fishapp-post/models"
 	"github.com/ezio1119/fishapp-post/usecase/interactor/saga"
 	"github.com/ezio1119/fishapp-post/usecase/repo"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// サガの更新が競合したときに読み直してやり直す最大回数
+const maxSagaConflictRetries = 3
+
 type sagaReplyInteractor struct {
-	createPostSagaManager *saga.CreatePostSagaManager
-	sagaInstanceRepo      repo.SagaInstanceRepo
+	sagaOrchestrator    *saga.Orchestrator
+	sagaInstanceRepo    repo.SagaInstanceRepo
+	receivedMessageRepo repo.ReceivedMessageRepo
 }
 
-func NewSagaReplyInteractor(m *saga.CreatePostSagaManager, sr repo.SagaInstanceRepo) SagaReplyInteractor {
-	return &sagaReplyInteractor{m, sr}
+func NewSagaReplyInteractor(
+	so *saga.Orchestrator,
+	sr repo.SagaInstanceRepo,
+	rr repo.ReceivedMessageRepo,
+) SagaReplyInteractor {
+	return &sagaReplyInteractor{so, sr, rr}
 }
 
 type SagaReplyInteractor interface {
-	RoomCreated(ctx context.Context, sagaID string) error
-	CreateRoomFailed(ctx context.Context, sagaID string, errMsg string) error
+	RoomCreated(ctx context.Context, msgID string, sagaID string) error
+	CreateRoomFailed(ctx context.Context, msgID string, sagaID string, errMsg string) error
+	RoomDeleted(ctx context.Context, msgID string, sagaID string) error
+	DeleteRoomFailed(ctx context.Context, msgID string, sagaID string, errMsg string) error
 }
 
-func (i *sagaReplyInteractor) RoomCreated(ctx context.Context, sagaID string) error {
-	sagaIn, err := i.sagaInstanceRepo.GetSagaInstance(ctx, sagaID)
-	if err != nil {
-		return err
-	}
-	p := &pb.Post{}
-	if err := protojson.Unmarshal(sagaIn.SagaData, p); err != nil {
-		return err
-	}
+func (i *sagaReplyInteractor) RoomCreated(ctx context.Context, msgID string, sagaID string) error {
+	return i.handleReply(ctx, msgID, "room.created", sagaID, "")
+}
 
-	state, err := saga.NewCreatePostSagaState(p, sagaIn.CurrentState, sagaID)
-	if err != nil {
-		return err
-	}
+func (i *sagaReplyInteractor) CreateRoomFailed(ctx context.Context, msgID string, sagaID string, errMsg string) error {
+	log.Printf("error: %s\n", errMsg)
+	return i.handleReply(ctx, msgID, "create.room.failed", sagaID, errMsg)
+}
 
-	s := i.createPostSagaManager.NewCreatePostSagaManager(state)
+func (i *sagaReplyInteractor) RoomDeleted(ctx context.Context, msgID string, sagaID string) error {
+	return i.handleReply(ctx, msgID, "room.deleted", sagaID, "")
+}
 
-	if err := s.FSM.Event("ApprovePost", ctx); err != nil {
-		if err := s.FSM.Event("RejectPost", ctx); err != nil {
+func (i *sagaReplyInteractor) DeleteRoomFailed(ctx context.Context, msgID string, sagaID string, errMsg string) error {
+	log.Printf("error: %s\n", errMsg)
+	return i.handleReply(ctx, msgID, "delete.room.failed", sagaID, errMsg)
+}
+
+// サガの種類ごとの定義に従って、リプライのイベントタイプに対応するステップを進める
+func (i *sagaReplyInteractor) handleReply(ctx context.Context, msgID string, eventType string, sagaID string, errMsg string) error {
+	msg := &models.ReceivedMessage{ID: msgID, EventType: eventType, CreatedAt: time.Now()}
+
+	return i.applyReply(ctx, msg, sagaID, func(sagaIn *models.SagaInstance) error {
+		s, err := i.sagaOrchestrator.Load(sagaIn)
+		if err != nil {
 			return err
 		}
-		return err
-	}
-	return nil
-
+		return s.HandleReply(ctx, msg, errMsg)
+	})
 }
 
-func (i *sagaReplyInteractor) CreateRoomFailed(ctx context.Context, sagaID string, errMsg string) error {
-	log.Printf("error: %s\n", errMsg)
+// サガインスタンスを読み込んでリプライを適用する
+// 他のハンドラが同時に同じサガを更新して競合した場合は、最新のステートを読み直してやり直す
+func (i *sagaReplyInteractor) applyReply(ctx context.Context, msg *models.ReceivedMessage, sagaID string, apply func(sagaIn *models.SagaInstance) error) error {
+	for n := 0; ; n++ {
+		received, err := i.isReceived(ctx, msg.ID)
+		if err != nil || received {
+			return err
+		}
 
-	sagaIn, err := i.sagaInstanceRepo.GetSagaInstance(ctx, sagaID)
-	if err != nil {
-		return err
-	}
+		sagaIn, err := i.sagaInstanceRepo.GetSagaInstance(ctx, sagaID)
+		if err != nil {
+			return err
+		}
+
+		err = ignoreAlreadyReceived(apply(sagaIn))
+
+		var conflictErr *models.SagaInstanceConflict
+		if errors.As(err, &conflictErr) && n < maxSagaConflictRetries {
+			log.Printf("%s. reload and retry", err)
+			continue
+		}
 
-	p := &pb.Post{}
-	if err := protojson.Unmarshal(sagaIn.SagaData, p); err != nil {
 		return err
 	}
+}
 
-	state, err := saga.NewCreatePostSagaState(p, sagaIn.CurrentState, sagaID)
+// 再配信や重複したメッセージはサガを進めずに捨てる
+func (i *sagaReplyInteractor) isReceived(ctx context.Context, msgID string) (bool, error) {
+	received, err := i.receivedMessageRepo.ExistsReceivedMessage(ctx, msgID)
 	if err != nil {
-		return err
+		return false, err
 	}
-
-	s := i.createPostSagaManager.NewCreatePostSagaManager(state)
-	if err := s.FSM.Event("RejectPost", ctx, errMsg); err != nil {
-		return err
+	if received {
+		log.Printf("message id=%s is already received. skip", msgID)
 	}
+	return received, nil
+}
 
-	return nil
+// 事前チェックをすり抜けて同時に処理されたメッセージは、トランザクション内の記録で弾かれる
+func ignoreAlreadyReceived(err error) error {
+	var alreadyErr *models.MessageAlreadyReceived
+	if errors.As(err, &alreadyErr) {
+		log.Println(err)
+		return nil
+	}
+	return err
 }
@@ -0,0 +1,62 @@
+//go:build integration
+// +build integration
+
+package interactor_test
+
+import (
+	"testing"
+
+	"github.com/ezio1119/fishapp-post/infrastructure"
+	"github.com/ezio1119/fishapp-post/infrastructure/sqlhandler"
+	"github.com/ezio1119/fishapp-post/interfaces/repo"
+	"github.com/google/uuid"
+)
+
+// メモリ上の実装と同じ確認を、MySQLのリポジトリに対して行う
+// 止まっているCreatePostSagaは他のデータのものも補償するので、docker-composeのMySQLだけで実行する
+func TestIntegrationSagaReplyInteractorConcurrentCreatePostSagas(t *testing.T) {
+	db, err := infrastructure.NewMySQLDB()
+	if err != nil {
+		t.Fatalf("failed to connect db: %s", err)
+	}
+	defer db.Close()
+
+	// 同時に開くトランザクションがmax_connectionsを超えないようにする
+	db.SetMaxOpenConns(50)
+
+	h := sqlhandler.NewSqlHandler(db)
+	r := sagaRepos{
+		or:  repo.NewOutboxRepo(h),
+		sr:  repo.NewSagaInstanceRepo(h),
+		str: repo.NewSagaTransitionRepo(h),
+		rr:  repo.NewReceivedMessageRepo(h),
+		tr:  repo.NewTransactionRepo(h),
+	}
+
+	sagaIDs := make([]string, 60)
+	for n := range sagaIDs {
+		sagaIDs[n] = uuid.New().String()
+	}
+
+	// 書き込んだ行は、outboxはcorrelation_idで、それ以外はサガのidで探して消す
+	correlationID := uuid.New().String()
+	defer func() {
+		if _, err := db.Exec("DELETE FROM outbox WHERE correlation_id = ?", correlationID); err != nil {
+			t.Errorf("failed to clean up: %s", err)
+		}
+		for _, sagaID := range sagaIDs {
+			queries := []string{
+				"DELETE FROM received_messages WHERE id LIKE CONCAT(?, '-_')",
+				"DELETE FROM saga_transitions WHERE saga_id = ?",
+				"DELETE FROM saga_instance WHERE id = ?",
+			}
+			for _, q := range queries {
+				if _, err := db.Exec(q, sagaID); err != nil {
+					t.Errorf("failed to clean up: %s", err)
+				}
+			}
+		}
+	}()
+
+	testConcurrentCreatePostSagas(t, r, correlationID, sagaIDs)
+}
@@ -0,0 +1,269 @@
+package interactor_test
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/internal/testutil/memory"
+	"github.com/ezio1119/fishapp-post/models"
+	"github.com/ezio1119/fishapp-post/pb"
+	"github.com/ezio1119/fishapp-post/usecase/interactor"
+	"github.com/ezio1119/fishapp-post/usecase/interactor/saga"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+	"github.com/google/uuid"
+)
+
+// サガのトランザクションと一緒にロールバックできないので、投稿のステータスは確かめずにoutboxで確かめる
+type fakePostRepo struct {
+	repo.PostRepo
+}
+
+func (fakePostRepo) UpdatePostStatus(ctx context.Context, id int64, status string, updatedAt time.Time) error {
+	return nil
+}
+
+type fakeImageRepo struct {
+	repo.ImageRepo
+}
+
+func (fakeImageRepo) DeleteImagesByPostID(ctx context.Context, postID int64) error {
+	return nil
+}
+
+// サガのテストで使うリポジトリ。メモリ上の実装か、integrationタグではMySQLの実装を渡す
+type sagaRepos struct {
+	or  repo.OutboxRepo
+	sr  repo.SagaInstanceRepo
+	str repo.SagaTransitionRepo
+	rr  repo.ReceivedMessageRepo
+	tr  repo.TransactionRepo
+}
+
+func newMemorySagaRepos() sagaRepos {
+	store := memory.NewStore()
+	return sagaRepos{
+		or:  memory.NewOutboxRepo(store),
+		sr:  memory.NewSagaInstanceRepo(store),
+		str: memory.NewSagaTransitionRepo(store),
+		rr:  memory.NewReceivedMessageRepo(store),
+		tr:  memory.NewTransactionRepo(store),
+	}
+}
+
+// go test -raceで実行する
+func TestSagaReplyInteractorConcurrentCreatePostSagas(t *testing.T) {
+	sagaIDs := make([]string, 300)
+	for n := range sagaIDs {
+		sagaIDs[n] = uuid.New().String()
+	}
+
+	testConcurrentCreatePostSagas(t, newMemorySagaRepos(), uuid.New().String(), sagaIDs)
+}
+
+// 同じサガへのリプライの再配信や重複、タイムアウトの補償と再発行を同時に起こしても、
+// サガは1回だけ終了ステートに入り、遷移ごとにoutboxの行が1つだけできることを確かめる
+// outboxの行はcorrelationIDで他のデータと区別する。リプライのメッセージのidはサガのidに-aか-bを付けて作る
+func testConcurrentCreatePostSagas(t *testing.T, r sagaRepos, correlationID string, sagaIDs []string) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	so := saga.NewOrchestrator(r.or, r.sr, r.str, r.rr, r.tr)
+	so.Register(saga.NewCreatePostSagaDefinition(fakePostRepo{}, fakeImageRepo{}, r.or))
+
+	replyI := interactor.NewSagaReplyInteractor(so, r.sr, r.rr)
+	timeoutI := interactor.NewSagaTimeoutInteractor(so, r.sr, time.Minute)
+
+	ctx := models.ContextWithEventMetadata(context.Background(), &models.EventMetadata{CorrelationID: correlationID})
+
+	// 止まったサガを待たずに、1回再発行してから補償する
+	stop := make(chan struct{})
+	var sweepWg sync.WaitGroup
+	for n := 0; n < 2; n++ {
+		sweepWg.Add(1)
+		go func() {
+			defer sweepWg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if _, err := timeoutI.SweepTimedOutSagas(ctx, 0, 1, 20); err != nil {
+					t.Errorf("failed to sweep sagas: %s", err)
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+
+	// NATSの再配信と同じく、エラーを返したリプライは成功するまで届け直す
+	deliver := func(reply func() error) {
+		for n := 0; ; n++ {
+			err := reply()
+			if err == nil {
+				return
+			}
+			if n == 100 {
+				t.Errorf("failed to handle reply: %s", err)
+				return
+			}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for n, sagaID := range sagaIDs {
+		wg.Add(1)
+		go func(n int, sagaID string) {
+			defer wg.Done()
+
+			s, err := so.New(saga.CreatePostSagaType, sagaID, &pb.Post{Id: int64(n + 1), UserId: 1})
+			if err != nil {
+				t.Errorf("failed to create saga: %s", err)
+				return
+			}
+
+			txCtx, err := r.tr.BeginTx(ctx)
+			if err != nil {
+				t.Errorf("failed to begin tx: %s", err)
+				return
+			}
+			if err := s.Begin(txCtx); err != nil {
+				r.tr.Roolback(txCtx)
+				t.Errorf("failed to begin saga: %s", err)
+				return
+			}
+			if _, err := r.tr.Commit(txCtx); err != nil {
+				t.Errorf("failed to commit: %s", err)
+				return
+			}
+
+			// 先にタイムアウトで補償されていれば進められない
+			s.Fire(ctx, "CreateRoom", &saga.StepInput{})
+
+			roomCreated := func(msgID string) func() error {
+				return func() error { return replyI.RoomCreated(ctx, msgID, sagaID) }
+			}
+			createRoomFailed := func(msgID string) func() error {
+				return func() error { return replyI.CreateRoomFailed(ctx, msgID, sagaID, "failed to create room") }
+			}
+
+			// 同じIDのメッセージの再配信、再発行したコマンドへの重複したリプライ、食い違うリプライを同時に届ける
+			msgA, msgB := sagaID+"-a", sagaID+"-b"
+			var replies []func() error
+			switch n % 3 {
+			case 0:
+				replies = []func() error{roomCreated(msgA), roomCreated(msgA), roomCreated(msgB)}
+			case 1:
+				replies = []func() error{createRoomFailed(msgA), createRoomFailed(msgA)}
+			case 2:
+				replies = []func() error{roomCreated(msgA), createRoomFailed(msgB)}
+			}
+
+			var replyWg sync.WaitGroup
+			for _, reply := range replies {
+				replyWg.Add(1)
+				go func(reply func() error) {
+					defer replyWg.Done()
+					deliver(reply)
+				}(reply)
+			}
+			replyWg.Wait()
+		}(n, sagaID)
+	}
+	wg.Wait()
+
+	close(stop)
+	sweepWg.Wait()
+
+	// リプライを受ける前にCreateRoomで失敗したサガなどを、最後に全て終わらせる
+	// MySQLのupdated_atは秒までなので、今の秒に更新したサガも対象にする
+	for {
+		cnt, err := timeoutI.SweepTimedOutSagas(ctx, -time.Second, 0, int64(len(sagaIDs)))
+		if err != nil {
+			t.Fatalf("failed to sweep sagas: %s", err)
+		}
+		if cnt == 0 {
+			break
+		}
+	}
+
+	outboxes, err := r.or.ListUnpublishedOutboxes(ctx, math.MaxInt64, math.MaxInt64)
+	if err != nil {
+		t.Fatalf("failed to list outboxes: %s", err)
+	}
+
+	// 再発行したコマンドは最初のコマンドと同じ内容になるので、payloadごとに遷移とoutboxの行の数を比べる
+	payloads := map[string]int{}
+	var numApproved int
+
+	for _, sagaID := range sagaIDs {
+		sagaIn, err := r.sr.GetSagaInstance(ctx, sagaID)
+		if err != nil {
+			t.Fatalf("failed to get saga: %s", err)
+		}
+
+		if !so.IsFinished(saga.CreatePostSagaType, sagaIn.CurrentState) {
+			t.Errorf("saga id=%s is not finished: %s", sagaID, sagaIn.CurrentState)
+		}
+
+		if sagaIn.CurrentState == "PostApproved" {
+			numApproved++
+		}
+
+		list, err := r.str.ListSagaTransitions(ctx, sagaID)
+		if err != nil {
+			t.Fatalf("failed to list transitions: %s", err)
+		}
+
+		state := "init"
+		var numFinished int
+		for _, tr := range list {
+			if tr.FromState != state {
+				t.Errorf("saga id=%s transition %s from %s, want from %s", sagaID, tr.Event, tr.FromState, state)
+			}
+			if !so.IsFinished(saga.CreatePostSagaType, tr.FromState) && so.IsFinished(saga.CreatePostSagaType, tr.ToState) {
+				numFinished++
+			}
+			state = tr.ToState
+
+			if tr.Payload != nil {
+				payloads[string(tr.Payload)]++
+			}
+		}
+
+		if numFinished != 1 {
+			t.Errorf("saga id=%s entered finished states %d times, want 1", sagaID, numFinished)
+		}
+		if state != sagaIn.CurrentState {
+			t.Errorf("saga id=%s is in state %s, but last transition is to %s", sagaID, sagaIn.CurrentState, state)
+		}
+	}
+
+	// 遷移に対応しない行は、承認したときに書き込むpost.createdだけ
+	var numCreated int
+	for _, o := range outboxes {
+		if o.CorrelationID != correlationID {
+			continue
+		}
+		if o.EventType == "post.created" {
+			numCreated++
+			continue
+		}
+		payloads[string(o.EventData)]--
+	}
+
+	for p, cnt := range payloads {
+		if cnt != 0 {
+			t.Errorf("%d more transitions than outboxes with payload %s", cnt, p)
+		}
+	}
+	if numCreated != numApproved {
+		t.Errorf("%d post.created outboxes for %d approved sagas", numCreated, numApproved)
+	}
+}
@@ -0,0 +1,98 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/usecase/interactor/saga"
+	"github.com/ezio1119/fishapp-post/usecase/repo"
+)
+
+type SagaTimeoutInteractor interface {
+	SweepTimedOutSagas(ctx context.Context, timeout time.Duration, maxRetries int64, num int64) (int64, error)
+}
+
+type sagaTimeoutInteractor struct {
+	sagaOrchestrator *saga.Orchestrator
+	sagaInstanceRepo repo.SagaInstanceRepo
+	ctxTimeout       time.Duration
+}
+
+func NewSagaTimeoutInteractor(
+	so *saga.Orchestrator,
+	sr repo.SagaInstanceRepo,
+	timeout time.Duration,
+) SagaTimeoutInteractor {
+	return &sagaTimeoutInteractor{so, sr, timeout}
+}
+
+// timeoutより長く止まっているサガを種類ごとに最大num件処理して、処理した件数を返す
+// リプライ待ちの場合はmaxRetries回まではコマンドを再発行し、それでも返ってこなければ補償する
+func (i *sagaTimeoutInteractor) SweepTimedOutSagas(ctx context.Context, timeout time.Duration, maxRetries int64, num int64) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	before := time.Now().Add(-timeout)
+
+	var cnt int64
+	for _, d := range i.sagaOrchestrator.Definitions() {
+		n, err := i.sweepSagas(ctx, d, before, maxRetries, num)
+		cnt += n
+		if err != nil {
+			return cnt, err
+		}
+	}
+
+	return cnt, nil
+}
+
+// 定義に従って再発行か補償をする
+func (i *sagaTimeoutInteractor) sweepSagas(ctx context.Context, d *saga.Definition, before time.Time, maxRetries int64, num int64) (int64, error) {
+	list, err := i.sagaInstanceRepo.ListStaleSagaInstances(ctx, d.SagaType, d.PendingStates, before, num)
+	if err != nil {
+		return 0, err
+	}
+
+	var cnt int64
+	for _, sagaIn := range list {
+		s, err := i.sagaOrchestrator.Load(sagaIn)
+		if err != nil {
+			log.Printf("error failed load saga id=%s: %s", sagaIn.ID, err)
+			continue
+		}
+
+		// 補償できなくなった後のステートは、回数に関係なく進め直す
+		if s.CanResume() {
+			if err := s.Resume(ctx); err != nil {
+				log.Printf("error failed resume saga id=%s: %s", sagaIn.ID, err)
+				continue
+			}
+			log.Printf("saga id=%s timed out in state %s. resumed", sagaIn.ID, sagaIn.CurrentState)
+			cnt++
+			continue
+		}
+
+		// 再発行の回数が残っていれば、今のステートで待っているコマンドを再発行する
+		if s.CanRetry() && sagaIn.RetryCount < maxRetries {
+			if err := s.Retry(ctx); err != nil {
+				log.Printf("error failed retry saga id=%s: %s", sagaIn.ID, err)
+				continue
+			}
+			log.Printf("saga id=%s timed out in state %s. retried (%d/%d)", sagaIn.ID, sagaIn.CurrentState, sagaIn.RetryCount+1, maxRetries)
+			cnt++
+			continue
+		}
+
+		// 再発行の回数を使い切ったか、コマンドを再発行できないステートで止まっている場合はすぐに補償する
+		if err := s.Compensate(ctx, fmt.Sprintf("saga timed out in state %s", sagaIn.CurrentState)); err != nil {
+			log.Printf("error failed compensate saga id=%s: %s", sagaIn.ID, err)
+			continue
+		}
+		log.Printf("saga id=%s timed out. compensated", sagaIn.ID)
+		cnt++
+	}
+
+	return cnt, nil
+}
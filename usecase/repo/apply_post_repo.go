@@ -2,16 +2,22 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
 )
 
 type ApplyPostRepo interface {
 	GetApplyPostByID(ctx context.Context, id int64) (*models.ApplyPost, error)
+	GetApplyPostByIDForUpdate(ctx context.Context, id int64) (*models.ApplyPost, error)
+	GetApplyPostByPostIDAndUserID(ctx context.Context, postID int64, userID int64) (*models.ApplyPost, error)
 	ListApplyPostsByUserID(ctx context.Context, userID int64) ([]*models.ApplyPost, error)
 	ListApplyPostsByPostID(ctx context.Context, postID int64) ([]*models.ApplyPost, error)
 	BatchGetApplyPostsByPostIDs(ctx context.Context, postIDs []int64) ([]*models.ApplyPost, error)
-	CountApplyPostsByPostID(ctx context.Context, postID int64) (int64, error)
+	ListWaitlistedApplyPostsByPostID(ctx context.Context, postID int64) ([]*models.ApplyPost, error) // 空き待ちになった順に返す
+	CountAcceptedSeatsByPostID(ctx context.Context, postID int64) (int64, error)                     // acceptedの応募のparty_sizeを合計する
 	CreateApplyPost(ctx context.Context, p *models.ApplyPost) error
+	UpdateApplyPost(ctx context.Context, p *models.ApplyPost) error // status, party_size, noteを更新する
+	UpdateApplyPostStatus(ctx context.Context, id int64, status string, updatedAt time.Time) error
 	DeleteApplyPost(ctx context.Context, id int64) error
 }
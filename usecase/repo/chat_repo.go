@@ -0,0 +1,10 @@
+package repo
+
+import (
+	"context"
+)
+
+type ChatRepo interface {
+	CreateMember(ctx context.Context, postID int64, userID int64) error
+	DeleteMember(ctx context.Context, postID int64, userID int64) error
+}
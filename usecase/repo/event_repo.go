@@ -0,0 +1,7 @@
+package repo
+
+import "context"
+
+type EventRepo interface {
+	PublishEvent(ctx context.Context, channel string, data []byte) error
+}
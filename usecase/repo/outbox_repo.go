@@ -2,10 +2,15 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
 )
 
 type OutboxRepo interface {
 	CreateOutbox(ctx context.Context, o *models.Outbox) error
+	ListUnpublishedOutboxes(ctx context.Context, maxAttempts int64, num int64) ([]*models.Outbox, error)
+	MarkOutboxPublished(ctx context.Context, id string, publishedAt time.Time) error
+	MarkOutboxFailed(ctx context.Context, id string, errMsg string) error
+	DeletePublishedOutboxes(ctx context.Context, publishedBefore time.Time, num int64) (int64, error)
 }
@@ -2,14 +2,20 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
 )
 
 type PostRepo interface {
 	GetPostByID(ctx context.Context, id int64) (*models.Post, error)
+	GetPostByIDForUpdate(ctx context.Context, id int64) (*models.Post, error) // 投稿の行をロックする
+	ListPublishedPostsMeetingBefore(ctx context.Context, before time.Time, num int64) ([]*models.Post, error)
 	ListPosts(ctx context.Context, p *models.Post, num int64, cursor int64, filter *models.PostFilter) ([]*models.Post, error)
 	UpdatePost(ctx context.Context, p *models.Post) error
+	UpdatePostStatus(ctx context.Context, id int64, status string, updatedAt time.Time) error
+	ClosePost(ctx context.Context, id int64, meetingBefore time.Time, updatedAt time.Time) (bool, error) // 公開中でmeeting_atがmeetingBefore以前の場合だけ締め切る
+	CancelPost(ctx context.Context, id int64, reason string, updatedAt time.Time) error
 	CreatePost(ctx context.Context, p *models.Post) error
 	DeletePost(ctx context.Context, id int64) error
 }
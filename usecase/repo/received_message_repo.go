@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/ezio1119/fishapp-post/models"
+)
+
+type ReceivedMessageRepo interface {
+	ExistsReceivedMessage(ctx context.Context, id string) (bool, error)
+	CreateReceivedMessage(ctx context.Context, m *models.ReceivedMessage) error
+	DeleteReceivedMessages(ctx context.Context, createdBefore time.Time, num int64) (int64, error)
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/ezio1119/fishapp-post/models"
 )
@@ -10,4 +11,8 @@ type SagaInstanceRepo interface {
 	GetSagaInstance(ctx context.Context, sagaID string) (*models.SagaInstance, error)
 	CreateSagaInstance(ctx context.Context, i *models.SagaInstance) error
 	UpdateSagaInstance(ctx context.Context, s *models.SagaInstance) error
+	ListSagaInstances(ctx context.Context, f *models.SagaInstanceFilter, num int64) ([]*models.SagaInstance, error)
+	ListStaleSagaInstances(ctx context.Context, sagaType string, states []string, before time.Time, num int64) ([]*models.SagaInstance, error)
+	IncrementSagaRetryCount(ctx context.Context, sagaID string, version int64, updatedAt time.Time) error
+	ExistsUnfinishedSagaInstance(ctx context.Context, sagaType string, finishedStates []string, dataID int64) (bool, error)
 }
@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/ezio1119/fishapp-post/models"
+)
+
+type SagaTransitionRepo interface {
+	CreateSagaTransition(ctx context.Context, t *models.SagaTransition) error
+	ListSagaTransitions(ctx context.Context, sagaID string) ([]*models.SagaTransition, error)
+}